@@ -0,0 +1,142 @@
+package api
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"nfl-scores/client"
+)
+
+// maxEntries bounds how many responses are kept in memory. Final games never
+// expire, so a long-running server would otherwise keep every game and date
+// ever asked for; the least recently used entries go first.
+const maxEntries = 512
+
+// Cache results reported in the X-Cache header
+const (
+	cacheHit    = "HIT"
+	cacheMiss   = "MISS"
+	cacheShared = "SHARED" // Waited on another request's upstream fetch
+)
+
+// errFetchPanicked is what callers waiting on a fetch see if it panics
+var errFetchPanicked = errors.New("upstream fetch failed")
+
+// responseCache keeps encoded responses in memory and coalesces concurrent
+// misses for the same key into a single upstream fetch. Lifetimes follow the
+// client package's cache policy.
+type responseCache struct {
+	mu       sync.Mutex
+	max      int
+	entries  map[string]*list.Element
+	order    *list.List // Of *cacheEntry, most recently used first
+	inflight map[string]*fetchCall
+	now      func() time.Time
+}
+
+type cacheEntry struct {
+	key     string
+	body    []byte
+	expires time.Time // Zero for entries that never expire
+}
+
+type fetchCall struct {
+	done   chan struct{}
+	bodies map[string][]byte
+	err    error
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		max:      maxEntries,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		inflight: make(map[string]*fetchCall),
+		now:      time.Now,
+	}
+}
+
+// get returns the body cached under key, or calls fetch to produce it. Only
+// one fetch per key runs at a time; concurrent callers share its result.
+// fetch returns the body and how long it stays fresh.
+func (c *responseCache) get(key string, fetch func() ([]byte, time.Duration, error)) ([]byte, string, error) {
+	return c.getGroup(key, key, func() (map[string][]byte, time.Duration, error) {
+		body, ttl, err := fetch()
+		return map[string][]byte{key: body}, ttl, err
+	})
+}
+
+// getGroup is get for documents built from the same upstream data: a miss
+// on any key in group runs one fetch, which returns the bodies of every key
+// in the group, and callers for the others share it.
+func (c *responseCache) getGroup(key, group string, fetch func() (map[string][]byte, time.Duration, error)) ([]byte, string, error) {
+	c.mu.Lock()
+	if body, ok := c.lookup(key); ok {
+		c.mu.Unlock()
+		return body, cacheHit, nil
+	}
+	if call, ok := c.inflight[group]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.bodies[key], cacheShared, call.err
+	}
+	call := &fetchCall{done: make(chan struct{}), err: errFetchPanicked}
+	c.inflight[group] = call
+	c.mu.Unlock()
+
+	// Release waiters even if fetch panics
+	var ttl time.Duration
+	defer func() {
+		c.mu.Lock()
+		delete(c.inflight, group)
+		if call.err == nil {
+			for k, body := range call.bodies {
+				c.store(k, body, ttl)
+			}
+		}
+		c.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.bodies, ttl, call.err = fetch()
+	return call.bodies[key], cacheMiss, call.err
+}
+
+// lookup returns the fresh body under key and marks it recently used,
+// dropping it if it has expired; callers hold c.mu
+func (c *responseCache) lookup(key string) ([]byte, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*cacheEntry)
+	if !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return entry.body, true
+}
+
+// store saves body under key, evicting the least recently used entries
+// when full; callers hold c.mu
+func (c *responseCache) store(key string, body []byte, ttl time.Duration) {
+	entry := &cacheEntry{key: key, body: body}
+	if ttl != client.CacheForever {
+		entry.expires = c.now().Add(ttl)
+	}
+	if e, ok := c.entries[key]; ok {
+		e.Value = entry
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
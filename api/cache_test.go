@@ -0,0 +1,151 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nfl-scores/client"
+)
+
+// fetched returns a fetch func that counts its calls
+func fetched(calls *atomic.Int32, body string, ttl time.Duration) func() ([]byte, time.Duration, error) {
+	return func() ([]byte, time.Duration, error) {
+		calls.Add(1)
+		return []byte(body), ttl, nil
+	}
+}
+
+func TestResponseCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newResponseCache()
+	c.max = 3
+	var calls atomic.Int32
+	key := func(i int) string { return fmt.Sprintf("summary:%d", i) }
+
+	// Final games never expire, so only the size bound removes them
+	for i := range 3 {
+		c.get(key(i), fetched(&calls, "{}", client.CacheForever))
+	}
+	c.get(key(0), fetched(&calls, "{}", client.CacheForever))
+	c.get(key(3), fetched(&calls, "{}", client.CacheForever))
+
+	if c.order.Len() != 3 || len(c.entries) != 3 {
+		t.Fatalf("cache holds %d/%d entries, want 3", len(c.entries), c.order.Len())
+	}
+	for i, want := range []string{cacheHit, cacheMiss} {
+		_, result, _ := c.get(key(i), fetched(&calls, "{}", client.CacheForever))
+		if result != want {
+			t.Errorf("game %d: X-Cache = %s, want %s", i, result, want)
+		}
+	}
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	c := newResponseCache()
+	now := time.Date(2024, 11, 17, 20, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	var calls atomic.Int32
+
+	c.get("live", fetched(&calls, "1", client.LiveCacheTTL))
+	now = now.Add(client.LiveCacheTTL - time.Second)
+	if body, result, _ := c.get("live", fetched(&calls, "2", client.LiveCacheTTL)); result != cacheHit || string(body) != "1" {
+		t.Errorf("within TTL: %s %s, want HIT 1", result, body)
+	}
+	now = now.Add(time.Second)
+	if body, result, _ := c.get("live", fetched(&calls, "2", client.LiveCacheTTL)); result != cacheMiss || string(body) != "2" {
+		t.Errorf("after TTL: %s %s, want MISS 2", result, body)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("fetched %d times, want 2", calls.Load())
+	}
+}
+
+func TestResponseCacheSharesFetch(t *testing.T) {
+	c := newResponseCache()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	slow := func() ([]byte, time.Duration, error) {
+		calls.Add(1)
+		<-release
+		return []byte("{}"), client.CacheForever, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, results[i], _ = c.get("k", slow)
+		}()
+	}
+	for {
+		c.mu.Lock()
+		_, started := c.inflight["k"]
+		c.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("fetched %d times, want 1", calls.Load())
+	}
+	misses := 0
+	for _, r := range results {
+		if r == cacheMiss {
+			misses++
+		}
+	}
+	if misses != 1 {
+		t.Errorf("results = %v, want one MISS", results)
+	}
+}
+
+func TestResponseCacheReleasesWaitersOnPanic(t *testing.T) {
+	c := newResponseCache()
+	release := make(chan struct{})
+	go func() {
+		defer func() { recover() }()
+		c.get("k", func() ([]byte, time.Duration, error) {
+			<-release
+			panic("boom")
+		})
+	}()
+	for {
+		c.mu.Lock()
+		_, started := c.inflight["k"]
+		c.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	done := make(chan error)
+	go func() {
+		_, _, err := c.get("k", nil)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("waiter got no error from the failed fetch")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter still blocked after the fetch panicked")
+	}
+
+	var calls atomic.Int32
+	if _, result, _ := c.get("k", fetched(&calls, "{}", client.CacheForever)); result != cacheMiss || calls.Load() != 1 {
+		t.Errorf("after the panic: %s with %d fetches, want a fresh MISS", result, calls.Load())
+	}
+}
@@ -0,0 +1,171 @@
+// Package api serves ScoreService data as a local JSON REST API, so several
+// tools can share one cached upstream connection
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/output"
+	"nfl-scores/service"
+)
+
+// fetchTimeout bounds one upstream fetch, including client retries. Fetches
+// are shared between requests, so they don't use any one request's context.
+const fetchTimeout = 30 * time.Second
+
+// errNotFound is returned when ESPN has no game for an ID
+var errNotFound = errors.New("game not found")
+
+// Server exposes ScoreService over HTTP. Responses use the documents from
+// the output package (docs/output-schema.md).
+type Server struct {
+	service *service.ScoreService
+	cache   *responseCache
+}
+
+// NewServer creates an API server backed by svc
+func NewServer(svc *service.ScoreService) *Server {
+	return &Server{
+		service: svc,
+		cache:   newResponseCache(),
+	}
+}
+
+// Handler routes the API endpoints:
+//
+//	GET /scoreboard?dates=YYYYMMDD[-YYYYMMDD]
+//	GET /games/{id}/summary
+//	GET /games/{id}/replay
+//	GET /games/{id}/stats
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /scoreboard", s.handleScoreboard)
+	mux.HandleFunc("GET /games/{id}/summary", s.handleSummary)
+	mux.HandleFunc("GET /games/{id}/replay", s.handleReplay)
+	mux.HandleFunc("GET /games/{id}/stats", s.handleStats)
+	return mux
+}
+
+func (s *Server) handleScoreboard(w http.ResponseWriter, r *http.Request) {
+	dates := r.URL.Query().Get("dates")
+	s.serve(w, "scoreboard:"+dates, func(ctx context.Context) (any, time.Duration, error) {
+		games, err := s.service.GetScoresByDates(ctx, dates)
+		if err != nil {
+			return nil, 0, err
+		}
+		return output.NewScoreboard(games), client.ScoreboardTTL(dates, games), nil
+	})
+}
+
+// Game documents, all derived from one fetch of ESPN's game summary
+const (
+	docSummary = "summary"
+	docReplay  = "replay"
+	docStats   = "stats"
+)
+
+func (s *Server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	s.serveGame(w, r.PathValue("id"), docSummary)
+}
+
+func (s *Server) handleReplay(w http.ResponseWriter, r *http.Request) {
+	s.serveGame(w, r.PathValue("id"), docReplay)
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	s.serveGame(w, r.PathValue("id"), docStats)
+}
+
+// serve answers from the cache, fetching and encoding the document on a miss
+func (s *Server) serve(w http.ResponseWriter, key string, fetch func(ctx context.Context) (any, time.Duration, error)) {
+	body, result, err := s.cache.get(key, func() ([]byte, time.Duration, error) {
+		ctx, cancel := fetchContext()
+		defer cancel()
+
+		doc, ttl, err := fetch(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		body, err := json.Marshal(doc)
+		return body, ttl, err
+	})
+	writeBody(w, body, result, err)
+}
+
+// serveGame answers one of a game's documents. A miss on any of them fetches
+// the game once and caches all three, so clients asking for the summary,
+// replay and stats together cost a single upstream request.
+func (s *Server) serveGame(w http.ResponseWriter, id, doc string) {
+	key := func(doc string) string { return doc + ":" + id }
+	body, result, err := s.cache.getGroup(key(doc), "game:"+id, func() (map[string][]byte, time.Duration, error) {
+		ctx, cancel := fetchContext()
+		defer cancel()
+
+		summary, replay, stats, err := s.service.GetGameDetails(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		if summary == nil || replay == nil || stats == nil {
+			return nil, 0, errNotFound
+		}
+
+		docs := map[string]any{
+			docSummary: output.NewLiveSummary(summary),
+			docReplay:  output.NewReplay(replay),
+			docStats:   output.NewBoxScore(stats),
+		}
+		bodies := make(map[string][]byte, len(docs))
+		for name, d := range docs {
+			body, err := json.Marshal(d)
+			if err != nil {
+				return nil, 0, err
+			}
+			bodies[key(name)] = body
+		}
+		return bodies, client.StatusTTL(summary.Game.Status), nil
+	})
+	writeBody(w, body, result, err)
+}
+
+// fetchContext bounds an upstream fetch and marks it as polling, so ESPN is
+// asked again once this cache's entry expires rather than answering from
+// the client's disk cache, whose lifetime would add to this one
+func fetchContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(service.Polling(context.Background()), fetchTimeout)
+}
+
+// writeBody sends a cached document, or the error that kept it from loading
+func writeBody(w http.ResponseWriter, body []byte, result string, err error) {
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Cache", result)
+	w.Write(body)
+}
+
+// writeError maps client errors to HTTP statuses
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	var inputErr *client.InputError
+	var statusErr *client.StatusError
+	switch {
+	case errors.As(err, &inputErr):
+		status = http.StatusBadRequest
+	case errors.Is(err, errNotFound):
+		status = http.StatusNotFound
+	case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+		status = http.StatusNotFound
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
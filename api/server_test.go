@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/service"
+)
+
+func TestGameDocumentsShareOneFetch(t *testing.T) {
+	fake, err := fakeespn.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summaries atomic.Int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == fakeespn.SummaryPath {
+			summaries.Add(1)
+		}
+		fake.Handler().ServeHTTP(w, r)
+	}))
+	defer upstream.Close()
+	srv := httptest.NewServer(NewServer(service.NewScoreService(client.NewESPNClientWithBaseURL(upstream.URL))).Handler())
+	defer srv.Close()
+
+	for round := 1; round <= 2; round++ {
+		for _, doc := range []string{docSummary, docReplay, docStats} {
+			resp, err := http.Get(srv.URL + "/games/" + fakeespn.DefaultLiveGameID + "/" + doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("%s: status %d", doc, resp.StatusCode)
+			}
+			// Only the first request goes upstream
+			want := cacheHit
+			if round == 1 && doc == docSummary {
+				want = cacheMiss
+			}
+			if got := resp.Header.Get("X-Cache"); got != want {
+				t.Errorf("round %d %s: X-Cache = %s, want %s", round, doc, got, want)
+			}
+		}
+	}
+	if n := summaries.Load(); n != 1 {
+		t.Errorf("fetched the summary %d times, want 1", n)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"nfl-scores/client"
+)
+
+const cacheHelpText = `Usage:
+  nfl-scores cache clear   Remove all cached API responses
+  nfl-scores cache stats   Show cache location and entry counts
+`
+
+// runCacheCommand manages the on-disk response cache
+func runCacheCommand(args []string) {
+	if len(args) != 1 {
+		fmt.Print(cacheHelpText)
+		os.Exit(1)
+	}
+
+	dir, err := client.DefaultCacheDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	cache := client.NewCache(dir)
+
+	switch args[0] {
+	case "clear":
+		n, err := cache.Clear()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Removed %d cached responses from %s\n", n, dir)
+
+	case "stats":
+		stats, err := cache.Stats()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Cache directory: %s\n", stats.Dir)
+		fmt.Printf("Entries:         %d\n", stats.Entries)
+		fmt.Printf("  Fresh:         %d (%d final games kept forever)\n", stats.Fresh, stats.Forever)
+		fmt.Printf("  Expired:       %d\n", stats.Expired)
+		fmt.Printf("Size:            %.1f KB\n", float64(stats.Bytes)/1024)
+
+	default:
+		fmt.Print(cacheHelpText)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,259 @@
+// Package calendar maps NFL seasons and weeks, from the Hall of Fame Game
+// to the Super Bowl, to the date ranges ESPN's scoreboard accepts. Weeks
+// run Tuesday through Monday, like ESPN's.
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout is the ESPN `dates` query format
+const dateLayout = "20060102"
+
+// SeasonType is a part of the season, numbered like ESPN's seasontype
+type SeasonType int
+
+const (
+	Preseason  SeasonType = 1
+	Regular    SeasonType = 2
+	Postseason SeasonType = 3
+)
+
+// HallOfFame is preseason week 0, the Hall of Fame Game
+const HallOfFame = 0
+
+// Postseason rounds, numbered like ESPN's postseason weeks
+const (
+	WildCard   = 1
+	Divisional = 2
+	Conference = 3
+	ProBowl    = 4
+	SuperBowl  = 5
+)
+
+var roundLabels = map[int]string{
+	WildCard:   "Wild Card",
+	Divisional: "Divisional",
+	Conference: "Conference",
+	ProBowl:    "Pro Bowl",
+	SuperBowl:  "Super Bowl",
+}
+
+// roundNames maps --round spellings to rounds
+var roundNames = map[string]int{
+	"wildcard": WildCard, "wild-card": WildCard, "wc": WildCard,
+	"divisional": Divisional, "div": Divisional,
+	"conference": Conference, "championship": Conference, "conf": Conference,
+	"probowl": ProBowl, "pro-bowl": ProBowl,
+	"superbowl": SuperBowl, "super-bowl": SuperBowl, "sb": SuperBowl,
+}
+
+// Week is one Tuesday-to-Monday week of a season
+type Week struct {
+	Season int // Year the season started
+	Type   SeasonType
+	Number int       // Week within Type: 0-3 preseason, 1-18 regular, a round constant for postseason
+	Start  time.Time // Tuesday, midnight UTC
+	End    time.Time // The following Monday, midnight UTC
+}
+
+// Label names the week for display, e.g. "Week 14" or "Divisional"
+func (w Week) Label() string {
+	switch {
+	case w.Type == Postseason:
+		return roundLabels[w.Number]
+	case w.Type == Preseason && w.Number == HallOfFame:
+		return "Hall of Fame"
+	case w.Type == Preseason:
+		return fmt.Sprintf("Preseason %d", w.Number)
+	}
+	return fmt.Sprintf("Week %d", w.Number)
+}
+
+// Dates is the week as a YYYYMMDD-YYYYMMDD scoreboard range
+func (w Week) Dates() string {
+	return w.Start.Format(dateLayout) + "-" + w.End.Format(dateLayout)
+}
+
+// Contains reports whether t's calendar date, in t's own location, is a
+// day of the week
+func (w Week) Contains(t time.Time) bool {
+	day := civilDay(t)
+	return !day.Before(w.Start) && !day.After(w.End)
+}
+
+// RegularWeeks is the number of regular season weeks: 18 since 2021, 17 before
+func RegularWeeks(season int) int {
+	if season >= 2021 {
+		return 18
+	}
+	return 17
+}
+
+// Next is the week after w, rolling into the following season
+func (w Week) Next() Week {
+	weeks := Season(w.Season)
+	for i, week := range weeks {
+		if week.Start.Equal(w.Start) && i+1 < len(weeks) {
+			return weeks[i+1]
+		}
+	}
+	return Season(w.Season + 1)[0]
+}
+
+// Prev is the week before w, rolling back into the previous season
+func (w Week) Prev() Week {
+	weeks := Season(w.Season)
+	for i, week := range weeks {
+		if week.Start.Equal(w.Start) && i > 0 {
+			return weeks[i-1]
+		}
+	}
+	prev := Season(w.Season - 1)
+	return prev[len(prev)-1]
+}
+
+// PreseasonWeeks is the last preseason week number: 3 since 2021 (after
+// the Hall of Fame week), 4 before
+func PreseasonWeeks(season int) int {
+	if season >= 2021 {
+		return 3
+	}
+	return 4
+}
+
+// Kickoff is the Thursday after Labor Day, when the regular season opens
+func Kickoff(season int) time.Time {
+	day := time.Date(season, time.September, 1, 0, 0, 0, 0, time.UTC)
+	for day.Weekday() != time.Monday {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day.AddDate(0, 0, 3)
+}
+
+// RegularWeek returns week n (1-based) of the regular season
+func RegularWeek(season, n int) (Week, error) {
+	if n < 1 || n > RegularWeeks(season) {
+		return Week{}, fmt.Errorf("week must be 1-%d for the %d season", RegularWeeks(season), season)
+	}
+	return weekAt(season, Regular, n, n-1), nil
+}
+
+// PreseasonWeek returns preseason week n (HallOfFame, then 1-3). The last
+// preseason week ends a week before the regular season starts.
+func PreseasonWeek(season, n int) (Week, error) {
+	last := PreseasonWeeks(season)
+	if n < HallOfFame || n > last {
+		return Week{}, fmt.Errorf("preseason week must be 0-%d for the %d season (0 is the Hall of Fame Game)", last, season)
+	}
+	return weekAt(season, Preseason, n, n-last-2), nil
+}
+
+// PostseasonRound returns a postseason round (WildCard through SuperBowl)
+func PostseasonRound(season, round int) (Week, error) {
+	if round < WildCard || round > SuperBowl {
+		return Week{}, fmt.Errorf("postseason round must be %d-%d", WildCard, SuperBowl)
+	}
+	return weekAt(season, Postseason, round, RegularWeeks(season)+round-1), nil
+}
+
+// ParseRound reads a --round value: a name like "divisional" or "sb", or
+// a round number
+func ParseRound(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if round, ok := roundNames[s]; ok {
+		return round, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= WildCard && n <= SuperBowl {
+		return n, nil
+	}
+	return 0, fmt.Errorf("unknown round %q (want wildcard, divisional, conference, probowl or superbowl)", s)
+}
+
+// PostseasonDates spans every postseason round as one scoreboard range
+func PostseasonDates(season int) string {
+	first, _ := PostseasonRound(season, WildCard)
+	last, _ := PostseasonRound(season, SuperBowl)
+	return first.Start.Format(dateLayout) + "-" + last.End.Format(dateLayout)
+}
+
+// Season lists every week of a season, preseason through the Super Bowl
+func Season(season int) []Week {
+	var weeks []Week
+	for n := HallOfFame; n <= PreseasonWeeks(season); n++ {
+		w, _ := PreseasonWeek(season, n)
+		weeks = append(weeks, w)
+	}
+	return append(weeks, Weeks(season)...)
+}
+
+// WeekOf finds the week t falls in; there is none between the Super Bowl
+// and the Hall of Fame Game, or in the gap before week 1
+func WeekOf(t time.Time) (Week, bool) {
+	for _, w := range Season(SeasonOf(t)) {
+		if w.Contains(t) {
+			return w, true
+		}
+	}
+	return Week{}, false
+}
+
+// Current is the week in progress at now, or the next one to start
+func Current(now time.Time) Week {
+	if w, ok := WeekOf(now); ok {
+		return w
+	}
+	day := civilDay(now)
+	season := SeasonOf(now)
+	for _, w := range append(Season(season), Season(season+1)...) {
+		if w.Start.After(day) {
+			return w
+		}
+	}
+	return Season(season + 1)[0]
+}
+
+// Weeks lists the regular season and postseason in order
+func Weeks(season int) []Week {
+	var weeks []Week
+	for n := 1; n <= RegularWeeks(season); n++ {
+		w, _ := RegularWeek(season, n)
+		weeks = append(weeks, w)
+	}
+	for r := WildCard; r <= SuperBowl; r++ {
+		w, _ := PostseasonRound(season, r)
+		weeks = append(weeks, w)
+	}
+	return weeks
+}
+
+// SeasonOf is the season in progress (or most recently finished) at t.
+// January and February games belong to the previous year's season.
+func SeasonOf(t time.Time) int {
+	day := civilDay(t)
+	if day.Month() < time.March {
+		return day.Year() - 1
+	}
+	return day.Year()
+}
+
+// weekAt builds the week offset whole weeks after week 1 of the regular season
+func weekAt(season int, typ SeasonType, number, offset int) Week {
+	start := Kickoff(season).AddDate(0, 0, -2+7*offset)
+	return Week{
+		Season: season,
+		Type:   typ,
+		Number: number,
+		Start:  start,
+		End:    start.AddDate(0, 0, 6),
+	}
+}
+
+// civilDay is t's calendar date in its own location, as midnight UTC
+func civilDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
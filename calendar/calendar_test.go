@@ -0,0 +1,201 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKickoff(t *testing.T) {
+	for season, want := range map[int]string{
+		2020: "20200910",
+		2021: "20210909",
+		2024: "20240905",
+		2025: "20250904",
+	} {
+		if got := Kickoff(season).Format(dateLayout); got != want {
+			t.Errorf("Kickoff(%d) = %s, want %s", season, got, want)
+		}
+	}
+}
+
+func TestWeekDates(t *testing.T) {
+	week := func(w Week, err error) Week {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+	tests := []struct {
+		week  Week
+		label string
+		dates string
+	}{
+		{week(PreseasonWeek(2024, HallOfFame)), "Hall of Fame", "20240730-20240805"},
+		{week(PreseasonWeek(2024, 1)), "Preseason 1", "20240806-20240812"},
+		{week(PreseasonWeek(2024, 3)), "Preseason 3", "20240820-20240826"},
+		{week(PreseasonWeek(2020, 4)), "Preseason 4", "20200825-20200831"},
+		{week(RegularWeek(2024, 1)), "Week 1", "20240903-20240909"},
+		{week(RegularWeek(2024, 11)), "Week 11", "20241112-20241118"},
+		{week(RegularWeek(2024, 18)), "Week 18", "20241231-20250106"},
+		{week(PostseasonRound(2024, WildCard)), "Wild Card", "20250107-20250113"},
+		{week(PostseasonRound(2024, Divisional)), "Divisional", "20250114-20250120"},
+		{week(PostseasonRound(2024, Conference)), "Conference", "20250121-20250127"},
+		{week(PostseasonRound(2024, ProBowl)), "Pro Bowl", "20250128-20250203"},
+		{week(PostseasonRound(2024, SuperBowl)), "Super Bowl", "20250204-20250210"},
+		// 17-week seasons put the Super Bowl a week earlier
+		{week(PostseasonRound(2020, SuperBowl)), "Super Bowl", "20210202-20210208"},
+	}
+	for _, tt := range tests {
+		if got := tt.week.Label(); got != tt.label {
+			t.Errorf("%s: Label = %q, want %q", tt.dates, got, tt.label)
+		}
+		if got := tt.week.Dates(); got != tt.dates {
+			t.Errorf("%s: Dates = %s, want %s", tt.label, got, tt.dates)
+		}
+		if tt.week.Start.Weekday() != time.Tuesday || tt.week.End.Weekday() != time.Monday {
+			t.Errorf("%s runs %s-%s, want Tuesday-Monday", tt.label, tt.week.Start.Weekday(), tt.week.End.Weekday())
+		}
+	}
+
+	if got := PostseasonDates(2024); got != "20250107-20250210" {
+		t.Errorf("PostseasonDates(2024) = %s, want 20250107-20250210", got)
+	}
+}
+
+func TestWeekOutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"week 0", second(RegularWeek(2024, 0))},
+		{"week 19", second(RegularWeek(2024, 19))},
+		{"week 18 of a 17-week season", second(RegularWeek(2020, 18))},
+		{"preseason 4 after 2021", second(PreseasonWeek(2024, 4))},
+		{"preseason -1", second(PreseasonWeek(2024, -1))},
+		{"round 0", second(PostseasonRound(2024, 0))},
+		{"round 6", second(PostseasonRound(2024, 6))},
+	}
+	for _, tt := range tests {
+		if tt.err == nil {
+			t.Errorf("%s: no error", tt.name)
+		}
+	}
+}
+
+func second(_ Week, err error) error { return err }
+
+func TestWeekOf(t *testing.T) {
+	et, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		at    time.Time
+		label string // "" for no week
+		year  int
+	}{
+		{"opening Thursday", time.Date(2024, 9, 5, 20, 20, 0, 0, et), "Week 1", 2024},
+		{"Monday night", time.Date(2024, 9, 9, 23, 59, 0, 0, et), "Week 1", 2024},
+		{"Tuesday rollover", time.Date(2024, 9, 10, 0, 0, 0, 0, et), "Week 2", 2024},
+		// Monday night in New York is already Tuesday in UTC
+		{"Monday night in UTC", time.Date(2024, 9, 10, 2, 0, 0, 0, time.UTC), "Week 2", 2024},
+		{"Hall of Fame Game", time.Date(2024, 8, 1, 20, 0, 0, 0, et), "Hall of Fame", 2024},
+		{"preseason", time.Date(2024, 8, 17, 13, 0, 0, 0, et), "Preseason 2", 2024},
+		{"gap before week 1", time.Date(2024, 8, 30, 12, 0, 0, 0, et), "", 2024},
+		{"New Year's week", time.Date(2025, 1, 5, 13, 0, 0, 0, et), "Week 18", 2024},
+		{"wild card", time.Date(2025, 1, 11, 16, 30, 0, 0, et), "Wild Card", 2024},
+		{"divisional", time.Date(2025, 1, 19, 18, 30, 0, 0, et), "Divisional", 2024},
+		{"conference", time.Date(2025, 1, 26, 15, 0, 0, 0, et), "Conference", 2024},
+		{"Super Bowl", time.Date(2025, 2, 9, 18, 30, 0, 0, et), "Super Bowl", 2024},
+		{"after the Super Bowl", time.Date(2025, 2, 20, 12, 0, 0, 0, et), "", 2024},
+		{"offseason", time.Date(2025, 5, 1, 12, 0, 0, 0, et), "", 2025},
+	}
+	for _, tt := range tests {
+		w, ok := WeekOf(tt.at)
+		if tt.label == "" {
+			if ok {
+				t.Errorf("%s: in %s, want no week", tt.name, w.Label())
+			}
+		} else if !ok || w.Label() != tt.label || w.Season != tt.year {
+			t.Errorf("%s: %d %s (ok %v), want %d %s", tt.name, w.Season, w.Label(), ok, tt.year, tt.label)
+		}
+		if got := SeasonOf(tt.at); got != tt.year {
+			t.Errorf("%s: SeasonOf = %d, want %d", tt.name, got, tt.year)
+		}
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		want string // Dates of the week
+	}{
+		{"in a week", time.Date(2024, 11, 17, 13, 0, 0, 0, time.UTC), "20241112-20241118"},
+		{"gap before week 1", time.Date(2024, 8, 30, 12, 0, 0, 0, time.UTC), "20240903-20240909"},
+		{"after the Super Bowl", time.Date(2025, 2, 20, 12, 0, 0, 0, time.UTC), "20250729-20250804"},
+		{"offseason", time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC), "20250729-20250804"},
+	}
+	for _, tt := range tests {
+		if got := Current(tt.now).Dates(); got != tt.want {
+			t.Errorf("%s: Current = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNextPrev(t *testing.T) {
+	week18, _ := RegularWeek(2024, 18)
+	wildCard, _ := PostseasonRound(2024, WildCard)
+	superBowl, _ := PostseasonRound(2024, SuperBowl)
+	preseason3, _ := PreseasonWeek(2024, 3)
+	week1, _ := RegularWeek(2024, 1)
+	hallOfFame, _ := PreseasonWeek(2025, HallOfFame)
+
+	tests := []struct {
+		name      string
+		got, want Week
+	}{
+		{"week 18 to wild card", week18.Next(), wildCard},
+		{"wild card back to week 18", wildCard.Prev(), week18},
+		{"preseason into week 1", preseason3.Next(), week1},
+		{"week 1 back to preseason", week1.Prev(), preseason3},
+		{"Super Bowl into next season", superBowl.Next(), hallOfFame},
+		{"Hall of Fame back to last season", hallOfFame.Prev(), superBowl},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: %d %s, want %d %s", tt.name, tt.got.Season, tt.got.Label(), tt.want.Season, tt.want.Label())
+		}
+	}
+}
+
+func TestParseRound(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int // 0 for an error
+	}{
+		{"wildcard", WildCard},
+		{"Wild-Card", WildCard},
+		{" div ", Divisional},
+		{"championship", Conference},
+		{"probowl", ProBowl},
+		{"SB", SuperBowl},
+		{"3", Conference},
+		{"6", 0},
+		{"playoffs", 0},
+	}
+	for _, tt := range tests {
+		got, err := ParseRound(tt.in)
+		if tt.want == 0 {
+			if err == nil {
+				t.Errorf("ParseRound(%q) = %d, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseRound(%q) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
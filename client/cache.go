@@ -0,0 +1,298 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"nfl-scores/models"
+)
+
+// Cache lifetimes by game state, shared by every cache of ESPN data.
+// CacheForever marks responses that never go stale.
+const (
+	LiveCacheTTL      = 10 * time.Second
+	ScheduledCacheTTL = 5 * time.Minute
+	CacheForever      = time.Duration(-1)
+)
+
+// cacheVersion invalidates entries written in an older on-disk format. Entries
+// hold ESPN's response bodies as received, so changing the response models
+// never needs a bump.
+const cacheVersion = 7
+
+// Cache stores raw scoreboard and summary responses on disk; they are decoded
+// on read. Final games are kept forever, scheduled games for minutes and
+// in-progress games for seconds.
+type Cache struct {
+	dir string
+	now func() time.Time
+}
+
+// cacheEntry is the on-disk envelope around a cached response
+type cacheEntry struct {
+	Version   int             `json:"version"`
+	StoredAt  time.Time       `json:"storedAt"`
+	ExpiresAt *time.Time      `json:"expiresAt,omitempty"` // nil means never expires
+	Data      json.RawMessage `json:"data"`                // Response body as ESPN sent it
+}
+
+// CacheStats summarizes the contents of the cache directory
+type CacheStats struct {
+	Dir     string
+	Entries int
+	Fresh   int
+	Expired int
+	Forever int
+	Bytes   int64
+}
+
+// NewCache creates a cache rooted at dir
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir, now: time.Now}
+}
+
+// DefaultCacheDir returns the per-user cache directory for nfl-scores
+func DefaultCacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+	return filepath.Join(base, "nfl-scores"), nil
+}
+
+// WithCache serves responses from an on-disk cache when they are still fresh
+func WithCache(cache *Cache) Option {
+	return func(c *ESPNClient) {
+		c.cache = cache
+	}
+}
+
+type revalidateKey struct{}
+
+// Revalidate marks ctx as a live poll: fetches made with it skip the cache's
+// freshness window and ask ESPN, sending the last response's ETag so an
+// unchanged game costs a 304. Live polls can run faster than any fixed TTL,
+// and a cached body would hide new plays until it expired.
+func Revalidate(ctx context.Context) context.Context {
+	return context.WithValue(ctx, revalidateKey{}, true)
+}
+
+func revalidating(ctx context.Context) bool {
+	v, _ := ctx.Value(revalidateKey{}).(bool)
+	return v
+}
+
+// Clear removes every cached response and returns how many were deleted
+func (c *Cache) Clear() (int, error) {
+	files, err := c.files()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, f := range files {
+		if err := os.Remove(f); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, fmt.Errorf("failed to remove %s: %w", f, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Stats reports how many responses are cached and how many are still fresh
+func (c *Cache) Stats() (CacheStats, error) {
+	stats := CacheStats{Dir: c.dir}
+
+	files, err := c.files()
+	if err != nil {
+		return stats, err
+	}
+
+	now := c.now()
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			continue
+		}
+		stats.Entries++
+		stats.Bytes += info.Size()
+
+		entry, err := readCacheEntry(f)
+		switch {
+		case err != nil, entry.Version != cacheVersion:
+			stats.Expired++
+		case entry.ExpiresAt == nil:
+			stats.Forever++
+			stats.Fresh++
+		case now.Before(*entry.ExpiresAt):
+			stats.Fresh++
+		default:
+			stats.Expired++
+		}
+	}
+	return stats, nil
+}
+
+func (c *Cache) files() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache: %w", err)
+	}
+	return files, nil
+}
+
+// get returns the fresh response body stored under key
+func (c *Cache) get(key string) ([]byte, bool) {
+	entry, err := readCacheEntry(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	if entry.Version != cacheVersion {
+		return nil, false
+	}
+	if entry.ExpiresAt != nil && !c.now().Before(*entry.ExpiresAt) {
+		return nil, false
+	}
+	return entry.Data, true
+}
+
+// put stores a response body under key for ttl. Write failures are ignored;
+// the cache is best effort.
+func (c *Cache) put(key string, body []byte, ttl time.Duration) {
+	if !json.Valid(body) {
+		return
+	}
+
+	now := c.now()
+	entry := cacheEntry{Version: cacheVersion, StoredAt: now, Data: body}
+	if ttl != CacheForever {
+		expires := now.Add(ttl)
+		entry.ExpiresAt = &expires
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return
+	}
+
+	// Write then rename so concurrent readers never see a partial file
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return
+	}
+	tmp.Close()
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, unsafeFileChars.ReplaceAllString(key, "_")+".json")
+}
+
+func readCacheEntry(path string) (*cacheEntry, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// fromCache decodes a fresh cached response for key into v and reports
+// whether it found one. Live polls always go to the network.
+func (c *ESPNClient) fromCache(ctx context.Context, key string, v any) bool {
+	if c.cache == nil || revalidating(ctx) {
+		return false
+	}
+	body, ok := c.cache.get(key)
+	return ok && json.Unmarshal(body, v) == nil
+}
+
+// cacheKey namespaces keys by API host so alternate base URLs never share entries
+func (c *ESPNClient) cacheKey(key string) string {
+	if c.baseURL == baseURL {
+		return key
+	}
+	host := strings.TrimPrefix(strings.TrimPrefix(c.baseURL, "https://"), "http://")
+	return host + "-" + key
+}
+
+func scoreboardCacheKey(dates string) string {
+	if dates == "" {
+		dates = "current"
+	}
+	return "scoreboard-" + dates
+}
+
+func summaryCacheKey(gameID string) string {
+	return "summary-" + gameID
+}
+
+func scoreboardTTL(dates string, sb *models.ScoreboardResponse) time.Duration {
+	return ScoreboardTTL(dates, sb.ToGames())
+}
+
+func summaryTTL(s *models.SummaryResponse) time.Duration {
+	if len(s.Header.Competitions) == 0 {
+		return ScheduledCacheTTL
+	}
+	return StatusTTL(models.StatusFromState(s.Header.Competitions[0].Status.Type.State))
+}
+
+// ScoreboardTTL picks a lifetime from the least settled game on the board.
+// The undated "current" scoreboard rolls over to a new week, so it never lives forever.
+func ScoreboardTTL(dates string, games []models.Game) time.Duration {
+	ttl := CacheForever
+	if len(games) == 0 {
+		ttl = ScheduledCacheTTL
+	}
+	for _, g := range games {
+		ttl = ShorterTTL(ttl, StatusTTL(g.Status))
+	}
+	if strings.TrimSpace(dates) == "" {
+		ttl = ShorterTTL(ttl, ScheduledCacheTTL)
+	}
+	return ttl
+}
+
+// StatusTTL is how long data about a game in status stays fresh
+func StatusTTL(status models.GameStatus) time.Duration {
+	switch status {
+	case models.StatusFinal:
+		return CacheForever
+	case models.StatusInProgress:
+		return LiveCacheTTL
+	default:
+		return ScheduledCacheTTL
+	}
+}
+
+// ShorterTTL returns the shorter of two lifetimes, CacheForever being the longest
+func ShorterTTL(a, b time.Duration) time.Duration {
+	if a == CacheForever {
+		return b
+	}
+	if b == CacheForever {
+		return a
+	}
+	return min(a, b)
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nfl-scores/fakeespn"
+)
+
+func TestCacheKeepsRawBody(t *testing.T) {
+	c := NewCache(t.TempDir())
+	// Fields no model decodes must survive, so model changes can't drop them
+	body := []byte(`{"events":[{"id":"1","notInAnyModel":{"x":[1,2,3]}}]}`)
+	c.put("k", body, CacheForever)
+
+	got, ok := c.get("k")
+	if !ok {
+		t.Fatal("entry not found")
+	}
+	var want, have any
+	json.Unmarshal(body, &want)
+	json.Unmarshal(got, &have)
+	if !jsonEqual(want, have) {
+		t.Errorf("cached body = %s, want %s", got, body)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	c := NewCache(t.TempDir())
+	now := time.Date(2024, 11, 17, 20, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	c.put("live", []byte(`{}`), LiveCacheTTL)
+	c.put("final", []byte(`{}`), CacheForever)
+
+	now = now.Add(LiveCacheTTL - time.Second)
+	if _, ok := c.get("live"); !ok {
+		t.Error("live entry expired early")
+	}
+	now = now.Add(time.Hour)
+	if _, ok := c.get("live"); ok {
+		t.Error("live entry served after its TTL")
+	}
+	if _, ok := c.get("final"); !ok {
+		t.Error("final entry expired")
+	}
+}
+
+func TestCacheIgnoresOtherVersions(t *testing.T) {
+	c := NewCache(t.TempDir())
+	c.put("k", []byte(`{}`), CacheForever)
+
+	raw, err := os.ReadFile(c.path("k"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entry cacheEntry
+	json.Unmarshal(raw, &entry)
+	entry.Version = cacheVersion - 1
+	raw, _ = json.Marshal(entry)
+	os.WriteFile(c.path("k"), raw, 0o644)
+
+	if _, ok := c.get("k"); ok {
+		t.Error("entry from another cache version was served")
+	}
+}
+
+func TestFetchServesFinalGameFromCache(t *testing.T) {
+	fake, err := fakeespn.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fake.Handler().ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	for i := range 2 {
+		// A fresh client each time, as separate CLI runs would have
+		c := NewESPNClient(WithBaseURL(srv.URL), WithCache(NewCache(dir)))
+		summary, err := c.FetchGameSummary(ctx, fakeespn.DefaultLiveGameID)
+		if err != nil {
+			t.Fatalf("fetch %d: %v", i+1, err)
+		}
+		if n := len(summary.ToGameSummary().Plays); n == 0 {
+			t.Fatalf("fetch %d: no plays decoded", i+1)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("made %d requests, want 1 with the second served from cache", n)
+	}
+}
+
+func jsonEqual(a, b any) bool {
+	x, _ := json.Marshal(a)
+	y, _ := json.Marshal(b)
+	return string(x) == string(y)
+}
+
+func TestRevalidateSkipsFreshLiveEntry(t *testing.T) {
+	fake, err := fakeespn.Start(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fake.Close()
+
+	c := NewESPNClient(WithBaseURL(fake.URL()), WithCache(NewCache(t.TempDir())))
+	plays := func(ctx context.Context) int {
+		t.Helper()
+		s, err := c.FetchGameSummary(ctx, fakeespn.DefaultLiveGameID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(s.ToGameSummary().Plays)
+	}
+
+	ctx := context.Background()
+	first := plays(ctx)
+	if got := plays(ctx); got != first {
+		t.Fatalf("plain fetch within the live TTL saw %d plays, want the cached %d", got, first)
+	}
+	if got := plays(Revalidate(ctx)); got != first+1 {
+		t.Errorf("live poll saw %d plays, want %d from the network", got, first+1)
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"container/list"
+	"net/http"
+	"sync"
+)
+
+// maxValidators bounds how many URLs are remembered for revalidation. Live
+// polling only cycles through a handful; long-running servers would otherwise
+// keep a body for every game and date ever asked for.
+const maxValidators = 64
+
+// validator remembers the last 200 response for a URL so it can be
+// revalidated with If-None-Match / If-Modified-Since
+type validator struct {
+	url          string
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// conditionalStore holds validators for the most recently used URLs
+type conditionalStore struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]*list.Element
+	order   *list.List // Of *validator, most recently used first
+}
+
+func newConditionalStore() *conditionalStore {
+	return &conditionalStore{
+		max:     maxValidators,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// apply adds conditional headers for url, if a previous response is known
+func (s *conditionalStore) apply(req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.lookup(req.URL.String())
+	if !ok {
+		return
+	}
+	if v.etag != "" {
+		req.Header.Set("If-None-Match", v.etag)
+	}
+	if v.lastModified != "" {
+		req.Header.Set("If-Modified-Since", v.lastModified)
+	}
+}
+
+// store records the validators from a 200 response, dropping the least
+// recently used URL when full. Responses without validators are not kept,
+// since they can never be revalidated.
+func (s *conditionalStore) store(url string, header http.Header, body []byte) {
+	etag := header.Get("ETag")
+	lastModified := header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v := &validator{url: url, etag: etag, lastModified: lastModified, body: body}
+	if e, ok := s.entries[url]; ok {
+		e.Value = v
+		s.order.MoveToFront(e)
+		return
+	}
+	s.entries[url] = s.order.PushFront(v)
+	for s.order.Len() > s.max {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*validator).url)
+	}
+}
+
+// cached returns the body of the last 200 response for url after a 304
+func (s *conditionalStore) cached(url string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.lookup(url)
+	if !ok {
+		return nil, false
+	}
+	return v.body, true
+}
+
+// lookup finds url's validator and marks it recently used; callers hold s.mu
+func (s *conditionalStore) lookup(url string) (*validator, bool) {
+	e, ok := s.entries[url]
+	if !ok {
+		return nil, false
+	}
+	s.order.MoveToFront(e)
+	return e.Value.(*validator), true
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestConditionalStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	s := newConditionalStore()
+	s.max = 3
+	header := http.Header{"Etag": {`"v1"`}}
+	url := func(i int) string { return fmt.Sprintf("http://espn/summary?event=%d", i) }
+
+	for i := range 3 {
+		s.store(url(i), header, []byte("{}"))
+	}
+	// Polling game 0 keeps it around
+	if _, ok := s.cached(url(0)); !ok {
+		t.Fatal("game 0 missing before the store was full")
+	}
+	s.store(url(3), header, []byte("{}"))
+
+	for i, want := range []bool{true, false, true, true} {
+		if _, ok := s.cached(url(i)); ok != want {
+			t.Errorf("game %d kept = %v, want %v", i, ok, want)
+		}
+	}
+	if len(s.entries) != 3 || s.order.Len() != 3 {
+		t.Errorf("store holds %d/%d entries, want 3", len(s.entries), s.order.Len())
+	}
+}
+
+func TestConditionalStoreReplacesEntry(t *testing.T) {
+	s := newConditionalStore()
+	s.store("u", http.Header{"Etag": {`"a"`}}, []byte("1"))
+	s.store("u", http.Header{"Etag": {`"b"`}}, []byte("2"))
+	s.store("nothing", http.Header{}, []byte("3"))
+
+	req, _ := http.NewRequest(http.MethodGet, "u", nil)
+	s.apply(req)
+	if got := req.Header.Get("If-None-Match"); got != `"b"` {
+		t.Errorf("If-None-Match = %s, want the newest ETag", got)
+	}
+	if body, _ := s.cached("u"); string(body) != "2" {
+		t.Errorf("body = %s, want 2", body)
+	}
+	if _, ok := s.cached("nothing"); ok {
+		t.Error("kept a response without validators")
+	}
+	if s.order.Len() != 1 {
+		t.Errorf("store holds %d entries, want 1", s.order.Len())
+	}
+}
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"regexp"
+	"strings"
 	"time"
 
 	"nfl-scores/models"
@@ -26,84 +28,142 @@ var (
 	gameIDPattern     = regexp.MustCompile(`^\d+$`)
 )
 
+// InputError reports a malformed date range or game ID, rejected before any
+// request is made
+type InputError struct {
+	Msg string
+}
+
+func (e *InputError) Error() string {
+	return e.Msg
+}
+
 // ESPNClient handles communication with the ESPN API
 type ESPNClient struct {
 	httpClient *http.Client
 	baseURL    string
+	cache      *Cache
+	retry      retryPolicy
+	validators *conditionalStore
+}
+
+// Option configures an ESPNClient
+type Option func(*ESPNClient)
+
+// WithBaseURL points the client at an ESPN-compatible API, such as a local fakeespn server
+func WithBaseURL(url string) Option {
+	return func(c *ESPNClient) {
+		c.baseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithRecording saves every scoreboard and summary response under dir,
+// keyed by request path and query
+func WithRecording(dir string) Option {
+	return func(c *ESPNClient) {
+		base := c.httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		c.httpClient.Transport = newRecordingTransport(base, dir)
+	}
+}
+
+// WithPlayback serves responses recorded by WithRecording from dir instead of the network
+func WithPlayback(dir string) Option {
+	return func(c *ESPNClient) {
+		c.httpClient.Transport = newPlaybackTransport(dir)
+	}
 }
 
 // NewESPNClient creates a new ESPN API client with default configuration
-func NewESPNClient() *ESPNClient {
-	return &ESPNClient{
+func NewESPNClient(opts ...Option) *ESPNClient {
+	c := &ESPNClient{
 		httpClient: &http.Client{
 			Timeout: defaultTimeout,
 		},
 		baseURL: baseURL,
+		retry: retryPolicy{
+			maxAttempts: defaultMaxAttempts,
+			baseBackoff: defaultBaseBackoff,
+			maxBackoff:  defaultMaxBackoff,
+		},
+		validators: newConditionalStore(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewESPNClientWithBaseURL creates a client that talks to an ESPN-compatible
+// API at baseURL, such as a local fakeespn server
+func NewESPNClientWithBaseURL(baseURL string) *ESPNClient {
+	return NewESPNClient(WithBaseURL(baseURL))
 }
 
 // FetchScoreboard retrieves the current NFL scoreboard data
-func (c *ESPNClient) FetchScoreboard() (*models.ScoreboardResponse, error) {
-	return c.FetchScoreboardByDates("")
+func (c *ESPNClient) FetchScoreboard(ctx context.Context) (*models.ScoreboardResponse, error) {
+	return c.FetchScoreboardByDates(ctx, "")
 }
 
 // FetchScoreboardByDates retrieves NFL scoreboard for a date range (format: YYYYMMDD-YYYYMMDD)
-func (c *ESPNClient) FetchScoreboardByDates(dates string) (*models.ScoreboardResponse, error) {
+func (c *ESPNClient) FetchScoreboardByDates(ctx context.Context, dates string) (*models.ScoreboardResponse, error) {
 	url := c.baseURL + scoreboardPath
 	if dates != "" {
 		if !dateRangePattern.MatchString(dates) && !singleDatePattern.MatchString(dates) {
-			return nil, fmt.Errorf("invalid date format: expected YYYYMMDD or YYYYMMDD-YYYYMMDD")
+			return nil, &InputError{Msg: "invalid date format: expected YYYYMMDD or YYYYMMDD-YYYYMMDD"}
 		}
 		url = fmt.Sprintf("%s?dates=%s", url, dates)
 	}
 
-	resp, err := c.httpClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch scoreboard: %w", err)
+	key := c.cacheKey(scoreboardCacheKey(dates))
+	var scoreboard models.ScoreboardResponse
+	if c.fromCache(ctx, key, &scoreboard) {
+		return &scoreboard, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	body, err := c.getJSON(ctx, url, &scoreboard)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scoreboard: %w", err)
 	}
 
-	var scoreboard models.ScoreboardResponse
-	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&scoreboard); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	if c.cache != nil {
+		c.cache.put(key, body, scoreboardTTL(dates, &scoreboard))
 	}
 
 	return &scoreboard, nil
 }
 
 // FetchGameSummary retrieves detailed game data including plays
-func (c *ESPNClient) FetchGameSummary(gameID string) (*models.SummaryResponse, error) {
+func (c *ESPNClient) FetchGameSummary(ctx context.Context, gameID string) (*models.SummaryResponse, error) {
 	if !gameIDPattern.MatchString(gameID) {
-		return nil, fmt.Errorf("invalid game ID: expected numeric value")
+		return nil, &InputError{Msg: "invalid game ID: expected numeric value"}
+	}
+
+	key := c.cacheKey(summaryCacheKey(gameID))
+	var summary models.SummaryResponse
+	if c.fromCache(ctx, key, &summary) {
+		return &summary, nil
 	}
 
 	url := fmt.Sprintf("%s%s?event=%s", c.baseURL, summaryPath, gameID)
 
-	resp, err := c.httpClient.Get(url)
+	body, err := c.getJSON(ctx, url, &summary)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch game summary: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
 
-	var summary models.SummaryResponse
-	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&summary); err != nil {
-		return nil, fmt.Errorf("failed to parse game summary: %w", err)
+	if c.cache != nil {
+		c.cache.put(key, body, summaryTTL(&summary))
 	}
 
 	return &summary, nil
 }
 
 // FetchGameReplay retrieves full game data for replay mode
-func (c *ESPNClient) FetchGameReplay(gameID string) (*models.GameReplay, error) {
-	summary, err := c.FetchGameSummary(gameID)
+func (c *ESPNClient) FetchGameReplay(ctx context.Context, gameID string) (*models.GameReplay, error) {
+	summary, err := c.FetchGameSummary(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
@@ -111,10 +171,82 @@ func (c *ESPNClient) FetchGameReplay(gameID string) (*models.GameReplay, error)
 }
 
 // FetchGameStats retrieves game statistics
-func (c *ESPNClient) FetchGameStats(gameID string) (*models.GameStats, error) {
-	summary, err := c.FetchGameSummary(gameID)
+func (c *ESPNClient) FetchGameStats(ctx context.Context, gameID string) (*models.GameStats, error) {
+	summary, err := c.FetchGameSummary(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
 	return summary.ToGameStats(), nil
 }
+
+// getJSON fetches url and decodes the body into v, returning the raw body
+// for the cache
+func (c *ESPNClient) getJSON(ctx context.Context, url string, v any) ([]byte, error) {
+	body, err := c.getBody(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return body, nil
+}
+
+// getBody fetches url, retrying transient failures with exponential backoff
+// and honoring Retry-After on 429s
+func (c *ESPNClient) getBody(ctx context.Context, url string) ([]byte, error) {
+	var body []byte
+	var err error
+	for attempt := 1; attempt <= c.retry.maxAttempts; attempt++ {
+		if attempt > 1 {
+			if sleepErr := sleepCtx(ctx, c.retry.backoff(attempt-1, err)); sleepErr != nil {
+				return nil, sleepErr
+			}
+		}
+
+		body, err = c.getBodyOnce(ctx, url)
+		if err == nil || !shouldRetry(ctx, err) {
+			return body, err
+		}
+	}
+	return nil, err
+}
+
+func (c *ESPNClient) getBodyOnce(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.validators.apply(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		c.validators.store(url, resp.Header, body)
+		return body, nil
+
+	case http.StatusNotModified:
+		// Nothing changed since the last poll; reuse the body we already have
+		cached, ok := c.validators.cached(url)
+		if !ok {
+			return nil, &StatusError{StatusCode: resp.StatusCode}
+		}
+		return cached, nil
+
+	default:
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: ParseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"nfl-scores/fakeespn"
+)
+
+// statusRecorder counts the status codes a handler answers with
+type statusRecorder struct {
+	http.Handler
+	mu    sync.Mutex
+	codes []int
+}
+
+type recordingWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *recordingWriter) WriteHeader(code int) {
+	w.code = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rw := &recordingWriter{ResponseWriter: w, code: http.StatusOK}
+	s.Handler.ServeHTTP(rw, r)
+	s.mu.Lock()
+	s.codes = append(s.codes, rw.code)
+	s.mu.Unlock()
+}
+
+func (s *statusRecorder) last() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.codes) == 0 {
+		return 0
+	}
+	return s.codes[len(s.codes)-1]
+}
+
+// startFake serves the fake ESPN API, scripting the bundled game as live
+func startFake(t *testing.T) (*ESPNClient, *statusRecorder) {
+	t.Helper()
+	fake, err := fakeespn.New(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := &statusRecorder{Handler: fake.Handler()}
+	srv := httptest.NewServer(rec)
+	t.Cleanup(srv.Close)
+	return NewESPNClient(WithBaseURL(srv.URL), WithRetry(1)), rec
+}
+
+func TestFetchScoreboardFromFake(t *testing.T) {
+	c, _ := startFake(t)
+
+	sb, err := c.FetchScoreboard(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	states := make(map[string]string)
+	for _, e := range sb.Events {
+		states[e.ID] = e.Status.Type.State
+	}
+	if got := states[fakeespn.DefaultLiveGameID]; got != "in" {
+		t.Errorf("scripted game state = %q, want in", got)
+	}
+	if len(states) < 2 {
+		t.Errorf("got %d games, want the whole fixture board", len(states))
+	}
+}
+
+func TestFetchGameSummaryAdvancesLiveGame(t *testing.T) {
+	c, _ := startFake(t)
+	ctx := context.Background()
+
+	first, err := c.FetchGameSummary(ctx, fakeespn.DefaultLiveGameID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.FetchGameSummary(ctx, fakeespn.DefaultLiveGameID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := len(first.ToGameSummary().Plays)
+	after := len(second.ToGameSummary().Plays)
+	if after != before+5 {
+		t.Errorf("second poll has %d plays, want %d", after, before+5)
+	}
+}
+
+func TestNotModifiedReusesBody(t *testing.T) {
+	c, rec := startFake(t)
+	ctx := context.Background()
+
+	first, err := c.FetchScoreboard(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.FetchScoreboard(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.last() != http.StatusNotModified {
+		t.Fatalf("second fetch got %d, want a 304 revalidation", rec.last())
+	}
+	if len(second.Events) != len(first.Events) {
+		t.Errorf("304 body has %d events, want %d", len(second.Events), len(first.Events))
+	}
+}
+
+func TestFetchErrors(t *testing.T) {
+	c, _ := startFake(t)
+	ctx := context.Background()
+
+	var inputErr *InputError
+	if _, err := c.FetchGameSummary(ctx, "abc"); !errors.As(err, &inputErr) {
+		t.Errorf("bad game ID: got %v, want InputError", err)
+	}
+	if _, err := c.FetchScoreboardByDates(ctx, "2024"); !errors.As(err, &inputErr) {
+		t.Errorf("bad dates: got %v, want InputError", err)
+	}
+
+	var statusErr *StatusError
+	_, err := c.FetchGameSummary(ctx, "1")
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown game: got %v, want a 404 StatusError", err)
+	}
+}
+
+func TestCancelDuringBackoff(t *testing.T) {
+	requested := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		requested <- struct{}{}
+	}))
+	defer srv.Close()
+	c := NewESPNClient(WithBaseURL(srv.URL), WithRetry(3))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-requested
+		cancel()
+	}()
+	start := time.Now()
+	_, err := c.FetchScoreboardByDates(ctx, "")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %s, want as soon as it was cancelled", elapsed)
+	}
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+
+	"nfl-scores/models"
+)
+
+// Provider is a source of NFL game data. ESPNClient is the default
+// implementation; fixture, cached or mirrored feeds can be swapped in
+// without touching the service or UI layers. Implementations
+// should honor ctx cancellation.
+type Provider interface {
+	FetchScoreboardByDates(ctx context.Context, dates string) (*models.ScoreboardResponse, error)
+	FetchGameSummary(ctx context.Context, gameID string) (*models.SummaryResponse, error)
+	FetchGameReplay(ctx context.Context, gameID string) (*models.GameReplay, error)
+	FetchGameStats(ctx context.Context, gameID string) (*models.GameStats, error)
+}
+
+// Ensure ESPNClient satisfies Provider
+var _ Provider = (*ESPNClient)(nil)
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retry defaults for transient failures
+const (
+	defaultMaxAttempts = 4
+	defaultBaseBackoff = 500 * time.Millisecond
+	defaultMaxBackoff  = 8 * time.Second
+)
+
+// errNotRecorded is returned by playback when no fixture exists; retrying cannot help
+var errNotRecorded = errors.New("no recorded response")
+
+// StatusError reports a non-200 response from the API
+type StatusError struct {
+	StatusCode int
+	RetryAfter time.Duration // Parsed Retry-After header, zero if absent
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+// retryable reports whether a status code is worth retrying
+func (e *StatusError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// retryPolicy controls exponential backoff with full jitter
+type retryPolicy struct {
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+// WithRetry sets how many times a request is attempted before giving up.
+// One attempt disables retries.
+func WithRetry(maxAttempts int) Option {
+	return func(c *ESPNClient) {
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		c.retry.maxAttempts = maxAttempts
+	}
+}
+
+// backoff returns the delay before the given retry attempt (1-based).
+// A server-provided Retry-After always wins over the computed delay.
+func (p retryPolicy) backoff(attempt int, err error) time.Duration {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		return min(statusErr.RetryAfter, p.maxBackoff*4)
+	}
+
+	d := p.baseBackoff << (attempt - 1)
+	if d <= 0 || d > p.maxBackoff {
+		d = p.maxBackoff
+	}
+	return time.Duration(rand.Int64N(int64(d)) + 1)
+}
+
+// shouldRetry decides whether err is transient
+func shouldRetry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, errNotRecorded) {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.retryable()
+	}
+	// Transport failures (timeouts, resets, refused connections) are worth another try
+	return true
+}
+
+// ParseRetryAfter reads a Retry-After header in delta-seconds or HTTP-date
+// form, returning zero when it is absent or already past
+func ParseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// sleepCtx waits for d or until ctx is cancelled
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+// Package config loads and saves the user's settings file
+// ($XDG_CONFIG_HOME/nfl-scores/config.json)
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds defaults that would otherwise have to be passed as flags
+type Config struct {
+	Favorites []string `json:"favorites,omitempty"` // Team abbreviations, most favorite first
+	Plain     bool     `json:"plain,omitempty"`     // Default for --plain
+	Mascot    bool     `json:"mascot,omitempty"`    // Default for --mascot
+	Timezone  string   `json:"timezone,omitempty"`  // IANA name for kickoff times; empty for the system zone
+}
+
+// ErrInvalid is wrapped by Load's error when the file parses but holds a bad
+// setting; the Config returned with it is still usable for Set and Save
+var ErrInvalid = errors.New("invalid config")
+
+// Keys lists the settings understood by Get and Set
+var Keys = []string{"favorites", "plain", "mascot", "timezone"}
+
+// Dir is the nfl-scores config directory: $XDG_CONFIG_HOME/nfl-scores, or
+// ~/.config/nfl-scores when XDG_CONFIG_HOME is unset
+func Dir() (string, error) {
+	if base := os.Getenv("XDG_CONFIG_HOME"); base != "" {
+		return filepath.Join(base, "nfl-scores"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "nfl-scores"), nil
+}
+
+// DefaultPath is config.json in Dir
+func DefaultPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.json"), nil
+}
+
+// Load reads the config at path. A missing file is not an error and gives
+// the zero Config. A file with a bad setting returns the parsed Config and
+// an error wrapping ErrInvalid.
+func Load(path string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if _, err := cfg.Location(); err != nil {
+		return cfg, fmt.Errorf("%w %s: %w", ErrInvalid, path, err)
+	}
+	return cfg, nil
+}
+
+// Save writes the config to path, creating its directory
+func (c Config) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
+
+// Get returns a setting formatted the way Set accepts it
+func (c Config) Get(key string) (string, error) {
+	switch key {
+	case "favorites":
+		return strings.Join(c.Favorites, ","), nil
+	case "plain":
+		return strconv.FormatBool(c.Plain), nil
+	case "mascot":
+		return strconv.FormatBool(c.Mascot), nil
+	case "timezone":
+		return c.Timezone, nil
+	}
+	return "", unknownKey(key)
+}
+
+// Set parses and stores one setting. Favorites are a comma-separated list
+// of team abbreviations; an empty value clears a setting.
+func (c *Config) Set(key, value string) error {
+	value = strings.TrimSpace(value)
+	switch key {
+	case "favorites":
+		c.Favorites = nil
+		for _, team := range strings.Split(value, ",") {
+			if team = strings.ToUpper(strings.TrimSpace(team)); team != "" {
+				c.Favorites = append(c.Favorites, team)
+			}
+		}
+	case "plain", "mascot":
+		b := false
+		if value != "" {
+			var err error
+			if b, err = strconv.ParseBool(value); err != nil {
+				return fmt.Errorf("%s must be true or false, got %q", key, value)
+			}
+		}
+		if key == "plain" {
+			c.Plain = b
+		} else {
+			c.Mascot = b
+		}
+	case "timezone":
+		if value != "" {
+			if _, err := time.LoadLocation(value); err != nil {
+				return fmt.Errorf("unknown timezone %q (use an IANA name like America/Chicago)", value)
+			}
+		}
+		c.Timezone = value
+	default:
+		return unknownKey(key)
+	}
+	return nil
+}
+
+// Location is the configured timezone, or the system zone when unset
+func (c Config) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %w", c.Timezone, err)
+	}
+	return loc, nil
+}
+
+// FavoriteRank is the position of a team in Favorites, or -1
+func (c Config) FavoriteRank(abbr string) int {
+	for i, team := range c.Favorites {
+		if strings.EqualFold(team, abbr) {
+			return i
+		}
+	}
+	return -1
+}
+
+func unknownKey(key string) error {
+	return fmt.Errorf("unknown setting %q (want one of %s)", key, strings.Join(Keys, ", "))
+}
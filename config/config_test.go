@@ -0,0 +1,152 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string // "" for no file
+		want    Config
+		invalid bool // Error wraps ErrInvalid
+		fails   bool // Any other error
+	}{
+		{name: "missing file", want: Config{}},
+		{name: "empty object", file: `{}`, want: Config{}},
+		{
+			name: "every setting",
+			file: `{"favorites": ["KC", "BUF"], "plain": true, "mascot": true, "timezone": "America/Chicago"}`,
+			want: Config{Favorites: []string{"KC", "BUF"}, Plain: true, Mascot: true, Timezone: "America/Chicago"},
+		},
+		{name: "unknown keys ignored", file: `{"theme": "dark", "plain": true}`, want: Config{Plain: true}},
+		{name: "bad timezone", file: `{"timezone": "Mars/Base", "plain": true}`, want: Config{Timezone: "Mars/Base", Plain: true}, invalid: true},
+		{name: "malformed", file: `{"plain": `, fails: true},
+		{name: "wrong type", file: `{"plain": "yes"}`, fails: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if tt.file != "" {
+				if err := os.WriteFile(path, []byte(tt.file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cfg, err := Load(path)
+			switch {
+			case tt.fails:
+				if err == nil || errors.Is(err, ErrInvalid) {
+					t.Fatalf("err = %v, want a read or parse error", err)
+				}
+				return
+			case tt.invalid:
+				if !errors.Is(err, ErrInvalid) {
+					t.Fatalf("err = %v, want ErrInvalid", err)
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cfg, tt.want) {
+				t.Errorf("Load = %+v, want %+v", cfg, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		key, value string
+		want       string // What Get returns afterwards
+		fails      bool
+	}{
+		{key: "favorites", value: " kc, buf ,,DET ", want: "KC,BUF,DET"},
+		{key: "favorites", value: "", want: ""},
+		{key: "plain", value: "true", want: "true"},
+		{key: "plain", value: "1", want: "true"},
+		{key: "plain", value: "", want: "false"},
+		{key: "plain", value: "maybe", fails: true},
+		{key: "mascot", value: "TRUE", want: "true"},
+		{key: "timezone", value: "America/Chicago", want: "America/Chicago"},
+		{key: "timezone", value: "", want: ""},
+		{key: "timezone", value: "Mars/Base", fails: true},
+		{key: "theme", value: "dark", fails: true},
+	}
+	for _, tt := range tests {
+		var cfg Config
+		err := cfg.Set(tt.key, tt.value)
+		if tt.fails {
+			if err == nil {
+				t.Errorf("Set(%s, %q) succeeded, want an error", tt.key, tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Set(%s, %q): %v", tt.key, tt.value, err)
+			continue
+		}
+		if got, _ := cfg.Get(tt.key); got != tt.want {
+			t.Errorf("Set(%s, %q) then Get = %q, want %q", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSetSaveLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nfl-scores", "config.json")
+	var cfg Config
+	for key, value := range map[string]string{
+		"favorites": "kc,buf",
+		"plain":     "true",
+		"mascot":    "false",
+		"timezone":  "Europe/London",
+	} {
+		if err := cfg.Set(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cfg.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded, cfg) {
+		t.Errorf("loaded %+v, saved %+v", loaded, cfg)
+	}
+	for _, key := range Keys {
+		saved, _ := cfg.Get(key)
+		if got, _ := loaded.Get(key); got != saved {
+			t.Errorf("%s = %q after reload, want %q", key, got, saved)
+		}
+	}
+}
+
+func TestSetFixesInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"favorites": ["KC"], "timezone": "Mars/Base"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if !errors.Is(err, ErrInvalid) {
+		t.Fatalf("err = %v, want ErrInvalid", err)
+	}
+	if err := cfg.Set("timezone", "America/New_York"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Timezone != "America/New_York" || cfg.FavoriteRank("kc") != 0 {
+		t.Errorf("after fixing: %+v, want the new timezone and the old favorites", cfg)
+	}
+}
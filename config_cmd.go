@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"nfl-scores/config"
+)
+
+const configHelpText = `Usage:
+  nfl-scores config get [KEY]        Show one setting, or all of them
+  nfl-scores config set KEY VALUE    Change a setting ("" clears it)
+  nfl-scores config path             Show where the config file lives
+
+Settings:
+  favorites   Comma-separated team abbreviations, most favorite first (e.g. KC,BUF)
+  plain       Default for --plain (true/false)
+  mascot      Default for --mascot (true/false)
+  timezone    IANA zone for kickoff times (e.g. America/Chicago); empty for the system zone
+`
+
+// runConfigCommand reads and edits the config file
+func runConfigCommand(args []string) {
+	if len(args) == 0 {
+		fmt.Print(configHelpText)
+		os.Exit(1)
+	}
+
+	path, err := config.DefaultPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	// A bad setting shouldn't stop the commands used to find and fix it
+	cfg, err := config.Load(path)
+	if err != nil && !errors.Is(err, config.ErrInvalid) && args[0] != "path" {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	switch {
+	case args[0] == "path" && len(args) == 1:
+		fmt.Println(path)
+
+	case args[0] == "get" && len(args) == 1:
+		for _, key := range config.Keys {
+			value, _ := cfg.Get(key)
+			fmt.Printf("%-10s %s\n", key, value)
+		}
+
+	case args[0] == "get" && len(args) == 2:
+		value, err := cfg.Get(strings.ToLower(args[1]))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(value)
+
+	case args[0] == "set" && len(args) == 3:
+		if err := cfg.Set(strings.ToLower(args[1]), args[2]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := cfg.Save(path); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+	default:
+		fmt.Print(configHelpText)
+		os.Exit(1)
+	}
+}
+
+// kickoffZone picks the zone for kickoff times: --tz, then the config
+// file, then the system zone
+func kickoffZone(tz string, cfg config.Config) *time.Location {
+	if tz == "" {
+		loc, _ := cfg.Location() // Validated by loadUserConfig
+		return loc
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: unknown time zone %q (use an IANA name like America/Chicago)\n", tz)
+		os.Exit(1)
+	}
+	return loc
+}
+
+// loadUserConfig reads the config file for a normal run; a broken file is
+// reported rather than silently ignored
+func loadUserConfig() config.Config {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return config.Config{}
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, config.ErrInvalid) {
+			fmt.Fprintln(os.Stderr, "Fix the file, or use `nfl-scores config set` to change the setting.")
+		} else {
+			fmt.Fprintln(os.Stderr, "Fix or remove the file.")
+		}
+		os.Exit(1)
+	}
+	return cfg
+}
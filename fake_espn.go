@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"nfl-scores/fakeespn"
+)
+
+// runFakeESPN serves recorded ESPN fixtures on a local port
+func runFakeESPN(args []string) {
+	fs := flag.NewFlagSet("fake-espn", flag.ExitOnError)
+	addr := fs.String("addr", "127.0.0.1:8089", "Address to listen on")
+	fixtures := fs.String("fixtures", "", "Directory with scoreboard.json and summary-<id>.json fixtures")
+	live := fs.String("live", "", "Game ID to script as a live game")
+	playsPerPoll := fs.Int("plays-per-poll", 1, "Plays revealed per summary poll (0 = one drive per poll)")
+	fs.Parse(args)
+
+	var opts []fakeespn.Option
+	if *fixtures != "" {
+		opts = append(opts, fakeespn.WithFixtureDir(*fixtures))
+	}
+	if *live != "" {
+		opts = append(opts, fakeespn.WithLiveGame(*live, *playsPerPoll))
+	}
+
+	srv, err := fakeespn.New(opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Fake ESPN API listening on http://%s\n", *addr)
+	fmt.Printf("Use: nfl-scores --base-url http://%s\n", *addr)
+	if err := http.ListenAndServe(*addr, srv.Handler()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,481 @@
+// Package fakeespn is a local stand-in for the ESPN scoreboard and summary
+// endpoints. It serves recorded JSON fixtures and can script a completed
+// game as if it were live, revealing more plays on every summary poll.
+package fakeespn
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Paths mirror the ESPN API routes used by client.ESPNClient
+const (
+	ScoreboardPath = "/apis/site/v2/sports/football/nfl/scoreboard"
+	SummaryPath    = "/apis/site/v2/sports/football/nfl/summary"
+)
+
+// DefaultLiveGameID is the bundled fixture game used for scripted live play
+const DefaultLiveGameID = "401671001"
+
+//go:embed fixtures/*.json
+var bundledFixtures embed.FS
+
+// Server serves ESPN fixtures over HTTP
+type Server struct {
+	mu         sync.Mutex
+	scoreboard []byte
+	summaries  map[string][]byte
+	scripts    map[string]*liveScript
+	test       *httptest.Server
+}
+
+// liveScript tracks how far through a recorded game a scripted live game is
+type liveScript struct {
+	revealed     int // Number of plays visible to clients
+	playsPerPoll int // 0 advances a whole drive per poll
+}
+
+// Option configures a Server
+type Option func(*Server) error
+
+// WithFixtureDir loads scoreboard.json and summary-<id>.json files from dir,
+// replacing any bundled fixtures with the same name
+func WithFixtureDir(dir string) Option {
+	return func(s *Server) error {
+		return s.loadFixtures(os.DirFS(dir))
+	}
+}
+
+// WithLiveGame scripts a recorded game as in progress. Each summary poll
+// reveals playsPerPoll more plays, or the rest of the current drive when
+// playsPerPoll is zero.
+func WithLiveGame(gameID string, playsPerPoll int) Option {
+	return func(s *Server) error {
+		if _, ok := s.summaries[gameID]; !ok {
+			return fmt.Errorf("no summary fixture for game %s", gameID)
+		}
+		if playsPerPoll < 0 {
+			playsPerPoll = 0
+		}
+		// Start at the opening kickoff so the game shows as live on the scoreboard
+		s.scripts[gameID] = &liveScript{revealed: 1, playsPerPoll: playsPerPoll}
+		return nil
+	}
+}
+
+// New creates a fake ESPN server loaded with the bundled fixtures
+func New(opts ...Option) (*Server, error) {
+	s := &Server{
+		summaries: make(map[string][]byte),
+		scripts:   make(map[string]*liveScript),
+	}
+
+	sub, err := fs.Sub(bundledFixtures, "fixtures")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadFixtures(sub); err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start creates a server and listens on a random local port via httptest
+func Start(opts ...Option) (*Server, error) {
+	s, err := New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.test = httptest.NewServer(s.Handler())
+	return s, nil
+}
+
+// URL returns the base URL of a server created with Start
+func (s *Server) URL() string {
+	if s.test == nil {
+		return ""
+	}
+	return s.test.URL
+}
+
+// Close shuts down a server created with Start
+func (s *Server) Close() {
+	if s.test != nil {
+		s.test.Close()
+	}
+}
+
+// Advance reveals n more plays of a scripted live game without a poll
+func (s *Server) Advance(gameID string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if script, ok := s.scripts[gameID]; ok {
+		script.revealed += n
+	}
+}
+
+// Handler returns the HTTP handler serving the ESPN routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+ScoreboardPath, s.handleScoreboard)
+	mux.HandleFunc("GET "+SummaryPath, s.handleSummary)
+	return mux
+}
+
+func (s *Server) handleScoreboard(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var board map[string]any
+	if err := json.Unmarshal(s.scoreboard, &board); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	events, _ := board["events"].([]any)
+	kept := make([]any, 0, len(events))
+	for _, e := range events {
+		event, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		if !inDateRange(str(event["date"]), r.URL.Query().Get("dates")) {
+			continue
+		}
+		if script, ok := s.scripts[str(event["id"])]; ok {
+			if err := s.applyLiveScoreboard(event, script); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+		kept = append(kept, event)
+	}
+	board["events"] = kept
+
+	writeJSON(w, r, board)
+}
+
+func (s *Server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	gameID := r.URL.Query().Get("event")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, ok := s.summaries[gameID]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var summary map[string]any
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if script, ok := s.scripts[gameID]; ok {
+		script.advance(summary)
+		scriptSummary(summary, script.revealed)
+	}
+
+	writeJSON(w, r, summary)
+}
+
+// applyLiveScoreboard rewrites a scoreboard event to match the scripted game state
+func (s *Server) applyLiveScoreboard(event map[string]any, script *liveScript) error {
+	var summary map[string]any
+	if err := json.Unmarshal(s.summaries[str(event["id"])], &summary); err != nil {
+		return err
+	}
+	scriptSummary(summary, script.revealed)
+
+	header, _ := summary["header"].(map[string]any)
+	summaryComp := first(header["competitions"])
+	eventComp := first(event["competitions"])
+	if summaryComp == nil || eventComp == nil {
+		return nil
+	}
+
+	event["status"] = summaryComp["status"]
+	eventComp["status"] = summaryComp["status"]
+
+	scores := make(map[string]any)
+	for _, c := range list(summaryComp["competitors"]) {
+		scores[str(c["homeAway"])] = c["score"]
+	}
+	for _, c := range list(eventComp["competitors"]) {
+		c["score"] = scores[str(c["homeAway"])]
+	}
+	return nil
+}
+
+// advance moves the script forward by one poll's worth of plays
+func (l *liveScript) advance(summary map[string]any) {
+	drives := driveList(summary)
+	if l.playsPerPoll > 0 {
+		l.revealed += l.playsPerPoll
+		return
+	}
+
+	// Reveal through the end of the next incomplete drive
+	seen := 0
+	for _, d := range drives {
+		seen += len(list(d["plays"]))
+		if seen > l.revealed {
+			l.revealed = seen
+			return
+		}
+	}
+	l.revealed = seen
+}
+
+// scriptSummary trims a completed game summary down to its first n plays
+// and rewrites the header so it looks like an in-progress game
+func scriptSummary(summary map[string]any, n int) {
+	drives := driveList(summary)
+
+	total := 0
+	for _, d := range drives {
+		total += len(list(d["plays"]))
+	}
+	if n >= total {
+		return
+	}
+
+	header, _ := summary["header"].(map[string]any)
+	comp := first(header["competitions"])
+
+	if n <= 0 {
+		summary["drives"] = map[string]any{"previous": []any{}}
+		if comp != nil {
+			comp["status"] = statusJSON("pre", "STATUS_SCHEDULED", "Scheduled", false)
+			for _, c := range list(comp["competitors"]) {
+				c["score"] = "0"
+			}
+		}
+		summary["winprobability"] = []any{}
+		return
+	}
+
+	var previous []any
+	var current map[string]any
+	var last map[string]any
+	revealedIDs := make(map[string]bool)
+
+	remaining := n
+	for _, d := range drives {
+		plays := list(d["plays"])
+		if remaining > len(plays) {
+			previous = append(previous, d)
+			remaining -= len(plays)
+			for _, p := range plays {
+				revealedIDs[str(p["id"])] = true
+			}
+			continue
+		}
+
+		current = d
+		shown := plays[:remaining]
+		asAny := make([]any, len(shown))
+		for i, p := range shown {
+			asAny[i] = p
+			revealedIDs[str(p["id"])] = true
+		}
+		current["plays"] = asAny
+		last = shown[len(shown)-1]
+		break
+	}
+
+	if previous == nil {
+		previous = []any{}
+	}
+	summary["drives"] = map[string]any{
+		"previous": previous,
+		"current":  current,
+	}
+
+	if comp != nil && last != nil {
+		period, _ := last["period"].(map[string]any)
+		clock, _ := last["clock"].(map[string]any)
+		detail := fmt.Sprintf("%s - %s", str(clock["displayValue"]), periodName(num(period["number"])))
+		comp["status"] = statusJSON("in", "STATUS_IN_PROGRESS", detail, false)
+
+		for _, c := range list(comp["competitors"]) {
+			if str(c["homeAway"]) == "home" {
+				c["score"] = fmt.Sprintf("%d", num(last["homeScore"]))
+			} else {
+				c["score"] = fmt.Sprintf("%d", num(last["awayScore"]))
+			}
+		}
+	}
+
+	if wp, ok := summary["winprobability"].([]any); ok {
+		kept := make([]any, 0, len(wp))
+		for _, w := range wp {
+			if m, ok := w.(map[string]any); ok && revealedIDs[str(m["playId"])] {
+				kept = append(kept, m)
+			}
+		}
+		summary["winprobability"] = kept
+	}
+}
+
+func (s *Server) loadFixtures(fsys fs.FS) error {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return fmt.Errorf("failed to read fixtures: %w", err)
+	}
+
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("failed to read fixture %s: %w", name, err)
+		}
+		if !json.Valid(data) {
+			return fmt.Errorf("fixture %s is not valid JSON", name)
+		}
+
+		switch {
+		case name == "scoreboard.json":
+			s.scoreboard = data
+		case strings.HasPrefix(name, "summary-"):
+			id := strings.TrimSuffix(strings.TrimPrefix(name, "summary-"), ".json")
+			s.summaries[id] = data
+		}
+	}
+	return nil
+}
+
+// eastern is the zone whose calendar days ESPN buckets games by; without
+// the tz database, standard time is the best guess
+var eastern = func() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.FixedZone("EST", -5*60*60)
+	}
+	return loc
+}()
+
+// inDateRange reports whether an ESPN event date falls within a dates query
+func inDateRange(date, dates string) bool {
+	if dates == "" {
+		return true
+	}
+
+	t, err := time.Parse("2006-01-02T15:04Z", date)
+	if err != nil {
+		return true
+	}
+	day := t.In(eastern).Format("20060102")
+
+	from, to, found := strings.Cut(dates, "-")
+	if !found {
+		to = from
+	}
+	return day >= from && day <= to
+}
+
+func statusJSON(state, name, detail string, completed bool) map[string]any {
+	return map[string]any{
+		"type": map[string]any{
+			"name":        name,
+			"state":       state,
+			"completed":   completed,
+			"description": detail,
+			"detail":      detail,
+			"shortDetail": detail,
+		},
+	}
+}
+
+func periodName(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	case 4:
+		return "4th"
+	default:
+		return "OT"
+	}
+}
+
+func driveList(summary map[string]any) []map[string]any {
+	drives, _ := summary["drives"].(map[string]any)
+	return list(drives["previous"])
+}
+
+// Helpers for walking decoded JSON without a schema
+
+func str(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+func num(v any) int {
+	f, _ := v.(float64)
+	return int(f)
+}
+
+func list(v any) []map[string]any {
+	items, _ := v.([]any)
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func first(v any) map[string]any {
+	items := list(v)
+	if len(items) == 0 {
+		return nil
+	}
+	return items[0]
+}
+
+// writeJSON encodes v with a content-hash ETag and honors If-None-Match
+func writeJSON(w http.ResponseWriter, r *http.Request, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
@@ -0,0 +1,160 @@
+package fakeespn
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func get(t *testing.T, h http.Handler, path, etag string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// summaryState decodes the game state and revealed play count of a summary
+func summaryState(t *testing.T, body []byte) (state string, plays int) {
+	t.Helper()
+	var summary map[string]any
+	if err := json.Unmarshal(body, &summary); err != nil {
+		t.Fatalf("decode summary: %v", err)
+	}
+	header, _ := summary["header"].(map[string]any)
+	status, _ := first(header["competitions"])["status"].(map[string]any)
+	typ, _ := status["type"].(map[string]any)
+
+	drives, _ := summary["drives"].(map[string]any)
+	for _, d := range list(drives["previous"]) {
+		plays += len(list(d["plays"]))
+	}
+	if current, ok := drives["current"].(map[string]any); ok {
+		plays += len(list(current["plays"]))
+	}
+	return str(typ["state"]), plays
+}
+
+func TestLiveGameRevealsPlaysUntilFinal(t *testing.T) {
+	s, err := New(WithLiveGame(DefaultLiveGameID, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := s.Handler()
+
+	last := 0
+	for poll := 1; ; poll++ {
+		rec := get(t, h, SummaryPath+"?event="+DefaultLiveGameID, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("poll %d: status %d", poll, rec.Code)
+		}
+		state, plays := summaryState(t, rec.Body.Bytes())
+		if state == "post" {
+			if plays <= last {
+				t.Fatalf("final summary has %d plays, want more than %d", plays, last)
+			}
+			break
+		}
+		if state != "in" {
+			t.Fatalf("poll %d: state %q, want in", poll, state)
+		}
+		if want := 1 + 10*poll; plays != want {
+			t.Fatalf("poll %d: %d plays revealed, want %d", poll, plays, want)
+		}
+		last = plays
+		if poll > 50 {
+			t.Fatal("game never went final")
+		}
+	}
+}
+
+func TestLiveGameOnScoreboard(t *testing.T) {
+	s, err := New(WithLiveGame(DefaultLiveGameID, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := s.Handler()
+
+	rec := get(t, h, ScoreboardPath, "")
+	var board map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &board); err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, e := range list(board["events"]) {
+		if str(e["id"]) != DefaultLiveGameID {
+			continue
+		}
+		found = true
+		status, _ := e["status"].(map[string]any)
+		typ, _ := status["type"].(map[string]any)
+		if str(typ["state"]) != "in" {
+			t.Errorf("scripted game state = %q, want in", str(typ["state"]))
+		}
+	}
+	if !found {
+		t.Fatalf("scripted game %s missing from scoreboard", DefaultLiveGameID)
+	}
+
+	// The scoreboard doesn't advance the script, so it revalidates
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("no ETag on scoreboard")
+	}
+	if rec := get(t, h, ScoreboardPath, etag); rec.Code != http.StatusNotModified {
+		t.Errorf("revalidation status = %d, want 304", rec.Code)
+	}
+}
+
+func TestScoreboardDateFilter(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := get(t, s.Handler(), ScoreboardPath+"?dates=19990101", "")
+	var board map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &board); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(list(board["events"])); n != 0 {
+		t.Errorf("got %d events outside the date range, want 0", n)
+	}
+}
+
+func TestInDateRangeUsesEasternDays(t *testing.T) {
+	tests := []struct {
+		date, dates string
+		want        bool
+	}{
+		// 12:30 AM EDT on Tuesday belongs to Tuesday, not Monday night
+		{"2024-09-10T04:30Z", "20240909", false},
+		{"2024-09-10T04:30Z", "20240910", true},
+		// 11:30 PM EST on Monday is still Monday
+		{"2024-12-10T04:30Z", "20241209", true},
+		{"2024-12-10T04:30Z", "20241210", false},
+		{"2024-12-10T04:30Z", "20241201-20241209", true},
+		{"2024-12-10T04:30Z", "", true},
+	}
+	for _, tt := range tests {
+		if got := inDateRange(tt.date, tt.dates); got != tt.want {
+			t.Errorf("inDateRange(%s, %q) = %v, want %v", tt.date, tt.dates, got, tt.want)
+		}
+	}
+}
+
+func TestUnknownGame(t *testing.T) {
+	if _, err := New(WithLiveGame("1", 1)); err == nil {
+		t.Error("WithLiveGame accepted a game without a fixture")
+	}
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := get(t, s.Handler(), SummaryPath+"?event=1", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", rec.Code)
+	}
+}
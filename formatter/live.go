@@ -142,63 +142,3 @@ func (f *TerminalFormatter) formatLiveStyled(summary *models.GameSummary) string
 
 	return sb.String()
 }
-
-// FormatGameSelection renders a list of live games for selection
-func (f *TerminalFormatter) FormatGameSelection(games []models.Game) string {
-	if len(games) == 0 {
-		if f.plain {
-			return "No live games available."
-		}
-		return lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
-			Render("No live games available.")
-	}
-
-	var sb strings.Builder
-
-	if f.plain {
-		sb.WriteString("\nSelect a live game to track:\n\n")
-		for i, g := range games {
-			sb.WriteString(fmt.Sprintf("  [%d] %s %d @ %s %d (%s)\n",
-				i+1, g.AwayTeam.Abbreviation, g.AwayTeam.Score,
-				g.HomeTeam.Abbreviation, g.HomeTeam.Score, g.StatusText))
-		}
-		sb.WriteString("\nEnter number: ")
-	} else {
-		headerStyle := lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("39"))
-
-		numStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("226")).
-			Bold(true)
-
-		teamStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("255"))
-
-		scoreStyle := lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("40"))
-
-		statusStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241"))
-
-		sb.WriteString("\n" + headerStyle.Render(iconFootball+" Select a live game to track:") + "\n\n")
-
-		for i, g := range games {
-			num := numStyle.Render(fmt.Sprintf("[%d]", i+1))
-			away := teamStyle.Render(g.AwayTeam.Abbreviation)
-			home := teamStyle.Render(g.HomeTeam.Abbreviation)
-			awayScore := scoreStyle.Render(fmt.Sprintf("%d", g.AwayTeam.Score))
-			homeScore := scoreStyle.Render(fmt.Sprintf("%d", g.HomeTeam.Score))
-			status := statusStyle.Render(fmt.Sprintf("(%s)", g.StatusText))
-
-			sb.WriteString(fmt.Sprintf("  %s %s %s @ %s %s %s\n",
-				num, away, awayScore, home, homeScore, status))
-		}
-
-		sb.WriteString("\n" + statusStyle.Render("Enter number: "))
-	}
-
-	return sb.String()
-}
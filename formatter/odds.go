@@ -0,0 +1,82 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"nfl-scores/models"
+)
+
+// lineDetails is the betting line under a game: the spread, total and
+// moneylines before kickoff, and who covered and how the total went after
+// the final. Games in progress and games without a line get "".
+func lineDetails(game models.Game) string {
+	if game.Odds == nil {
+		return ""
+	}
+
+	var parts []string
+	switch game.Status {
+	case models.StatusScheduled:
+		if spread := game.SpreadText(); spread != "" {
+			parts = append(parts, "Line: "+spread)
+		}
+		if game.Odds.OverUnder > 0 {
+			parts = append(parts, "O/U "+models.FormatPoints(game.Odds.OverUnder))
+		}
+		if ml := game.MoneyLineText(); ml != "" {
+			parts = append(parts, "ML "+ml)
+		}
+
+	case models.StatusFinal:
+		res, ok := game.LineResult()
+		if !ok {
+			return ""
+		}
+		if res.HasSpread {
+			outcome := res.Covered + " covered"
+			if res.Push {
+				outcome = "push"
+			}
+			parts = append(parts, fmt.Sprintf("Line: %s, %s", game.SpreadText(), outcome))
+		}
+		if res.TotalLine != "" {
+			parts = append(parts, fmt.Sprintf("O/U %s, %s (%d)", models.FormatPoints(game.Odds.OverUnder), res.TotalLine, res.Total))
+		}
+	}
+	return truncate(strings.Join(parts, " · "), 70)
+}
+
+// FormatATSReport renders each team's record against the spread and the
+// total over a set of games; label names the range, e.g. "2024 Week 11"
+func (f *TerminalFormatter) FormatATSReport(report models.ATSReport, label string) string {
+	var sb strings.Builder
+	sb.WriteString(f.teamHeader("AGAINST THE SPREAD", label))
+
+	if report.Games == 0 {
+		sb.WriteString("  No finished games with a betting line.\n")
+		sb.WriteString(f.teamFooter())
+		return sb.String()
+	}
+
+	sb.WriteString(f.render(f.dimStyle(), fmt.Sprintf("  %-28s %-8s %6s   %-8s", "Team", "ATS", "Cover", "O/U")) + "\n")
+	for _, r := range report.Teams {
+		name := fmt.Sprintf("%-4s %s", r.Team, truncate(r.Name, 23))
+		cover := "-"
+		if r.Wins+r.Losses > 0 {
+			cover = fmt.Sprintf("%.0f%%", r.CoverRate()*100)
+		}
+		ats := fmt.Sprintf("%-8s", r.String())
+		if r.Wins > r.Losses {
+			ats = f.render(f.recordStyle(), ats)
+		}
+		fmt.Fprintf(&sb, "  %-28s %s %6s   %-8s\n", name, ats, cover, r.TotalString())
+	}
+
+	fav := report.Favorites
+	sb.WriteString("\n")
+	fmt.Fprintf(&sb, "  %d games with a line · Favorites %s ATS · Over/under %s\n",
+		report.Games, f.render(f.recordStyle(), fav.String()), fav.TotalString())
+	sb.WriteString(f.teamFooter())
+	return sb.String()
+}
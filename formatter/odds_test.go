@@ -0,0 +1,79 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"nfl-scores/models"
+)
+
+func TestLineDetails(t *testing.T) {
+	tests := []struct {
+		name   string
+		status models.GameStatus
+		odds   *models.Odds
+		away   int
+		home   int
+		want   string
+	}{
+		{"no odds", models.StatusScheduled, nil, 0, 0, ""},
+		{
+			"scheduled", models.StatusScheduled,
+			&models.Odds{Spread: -2.5, OverUnder: 47.5, AwayMoneyLine: 115, HomeMoneyLine: -135}, 0, 0,
+			"Line: BUF -2.5 · O/U 47.5 · ML KC +115 / BUF -135",
+		},
+		{"favorite flag wins", models.StatusScheduled, &models.Odds{Spread: 3, HomeFavorite: true}, 0, 0, "Line: BUF -3"},
+		{"pick'em", models.StatusScheduled, &models.Odds{Details: "EVEN", OverUnder: 44}, 0, 0, "Line: EVEN · O/U 44"},
+		{"in progress", models.StatusInProgress, &models.Odds{Spread: -2.5}, 7, 3, ""},
+		{
+			"covered", models.StatusFinal, &models.Odds{Spread: -2.5, OverUnder: 45.5}, 21, 30,
+			"Line: BUF -2.5, BUF covered · O/U 45.5, over (51)",
+		},
+		{
+			"push", models.StatusFinal, &models.Odds{Spread: -3, OverUnder: 41}, 17, 20,
+			"Line: BUF -3, push · O/U 41, under (37)",
+		},
+		{"pick'em final", models.StatusFinal, &models.Odds{Details: "EVEN"}, 24, 20, "Line: EVEN, KC covered"},
+		{"no line on final", models.StatusFinal, &models.Odds{Provider: "ESPN BET"}, 24, 20, ""},
+	}
+	for _, tt := range tests {
+		game := models.Game{
+			Status:   tt.status,
+			AwayTeam: models.Team{Abbreviation: "KC", Score: tt.away},
+			HomeTeam: models.Team{Abbreviation: "BUF", Score: tt.home},
+			Odds:     tt.odds,
+		}
+		if got := lineDetails(game); got != tt.want {
+			t.Errorf("%s: lineDetails = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormatATSReport(t *testing.T) {
+	f := NewTerminalFormatter(80, true)
+
+	if got := f.FormatATSReport(models.ATSReport{}, "2024 Week 11"); !strings.Contains(got, "No finished games with a betting line.") {
+		t.Errorf("empty report:\n%s", got)
+	}
+
+	report := models.ATSReport{
+		Games:     2,
+		Favorites: models.ATSRecord{Team: "FAV", Name: "Favorites", Wins: 1, Pushes: 1, Overs: 1, Unders: 1},
+		Teams: []models.ATSRecord{
+			{Team: "BUF", Name: "Buffalo Bills", Wins: 1, Overs: 1},
+			{Team: "DET", Name: "Detroit Lions", Pushes: 1, Unders: 1},
+		},
+	}
+	got := f.FormatATSReport(report, "2024 Week 11")
+	for _, want := range []string{
+		"AGAINST THE SPREAD",
+		"BUF  Buffalo Bills",
+		"1-0        100%   1-0",
+		"0-0-1         -   0-1",
+		"2 games with a line · Favorites 1-0-1 ATS · Over/under 1-1",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report is missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -27,6 +27,9 @@ func (f *TerminalFormatter) formatStatsPlain(stats *models.GameStats) string {
 		g.AwayTeam.Name, g.AwayTeam.Score,
 		g.HomeTeam.Name, g.HomeTeam.Score,
 		g.StatusText)
+	if odds := lineDetails(g); odds != "" {
+		sb.WriteString("  " + odds + "\n")
+	}
 	sb.WriteString(line + "\n\n")
 
 	// Team comparison
@@ -132,6 +135,9 @@ func (f *TerminalFormatter) formatStatsStyled(stats *models.GameStats) string {
 		teamStyle.Render(g.HomeTeam.Abbreviation),
 		scoreStyle.Render(fmt.Sprintf("%d", g.HomeTeam.Score)),
 		labelStyle.Render(g.StatusText))
+	if odds := lineDetails(g); odds != "" {
+		sb.WriteString("  " + labelStyle.Render(odds) + "\n")
+	}
 	sb.WriteString(border + "\n\n")
 
 	// Team comparison header
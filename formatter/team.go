@@ -0,0 +1,214 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"nfl-scores/calendar"
+	"nfl-scores/models"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// kickoffLayout is how team views show kickoff times
+const kickoffLayout = "Mon Jan 02  3:04 PM"
+
+// FormatTeamSchedule renders a team's season week by week, marking byes
+func (f *TerminalFormatter) FormatTeamSchedule(team string, season int, schedule []models.TeamGame) string {
+	var sb strings.Builder
+	sb.WriteString(f.teamHeader(teamName(team, schedule), fmt.Sprintf("%d Schedule", season)))
+
+	byWeek := make(map[string][]models.TeamGame)
+	for _, g := range schedule {
+		byWeek[g.Week.Dates()] = append(byWeek[g.Week.Dates()], g)
+	}
+	for _, week := range calendar.Weeks(season) {
+		games := byWeek[week.Dates()]
+		if len(games) == 0 {
+			if week.Type == calendar.Regular && len(schedule) > 0 {
+				sb.WriteString(f.render(f.dimStyle(), fmt.Sprintf("  %-11s BYE", week.Label())) + "\n")
+			}
+			continue
+		}
+		for _, g := range games {
+			sb.WriteString(f.teamGameRow(g) + "\n")
+		}
+	}
+	if len(schedule) == 0 {
+		sb.WriteString("  No games found.\n")
+	}
+	sb.WriteString(f.teamFooter())
+	return sb.String()
+}
+
+// FormatTeamResults renders a team's finished games with scores
+func (f *TerminalFormatter) FormatTeamResults(team string, season int, schedule []models.TeamGame) string {
+	var sb strings.Builder
+	sb.WriteString(f.teamHeader(teamName(team, schedule), fmt.Sprintf("%d Results", season)))
+
+	n := 0
+	for _, g := range schedule {
+		if g.Game.Status == models.StatusFinal {
+			sb.WriteString(f.teamGameRow(g) + "\n")
+			n++
+		}
+	}
+	if n == 0 {
+		sb.WriteString("  No finished games yet.\n")
+	}
+	sb.WriteString(f.teamFooter())
+	return sb.String()
+}
+
+// FormatTeamRecord renders a team's regular season record, and its playoff
+// record once it has one
+func (f *TerminalFormatter) FormatTeamRecord(team string, season int, regular, playoffs models.TeamRecord, schedule []models.TeamGame) string {
+	var sb strings.Builder
+	sb.WriteString(f.teamHeader(teamName(team, schedule), fmt.Sprintf("%d Record", season)))
+
+	row := func(label, value string) {
+		fmt.Fprintf(&sb, "  %-16s %s\n", label, value)
+	}
+	row("Record", f.render(f.recordStyle(), regular.String()))
+	row("Home", regular.Home())
+	row("Away", regular.Away())
+	row("Points", fmt.Sprintf("%d for, %d against (%+d)", regular.PointsFor, regular.PointsAgainst, regular.PointsFor-regular.PointsAgainst))
+	if regular.Streak != "" {
+		row("Streak", regular.Streak)
+	}
+	if playoffs.Wins+playoffs.Losses+playoffs.Ties > 0 {
+		row("Playoffs", playoffs.String())
+	}
+	sb.WriteString(f.teamFooter())
+	return sb.String()
+}
+
+// FormatNextGame renders a team's next (or current) game with its kickoff
+// in local time
+func (f *TerminalFormatter) FormatNextGame(team string, season int, next *models.TeamGame, now time.Time) string {
+	if next == nil {
+		return fmt.Sprintf("%s has no more games in the %d season.\n", team, season)
+	}
+
+	g := *next
+	opponent := "vs " + g.Opponent().Name
+	if !g.Home {
+		opponent = "@ " + g.Opponent().Name
+	}
+
+	var sb strings.Builder
+	if g.Game.Status == models.StatusInProgress {
+		fmt.Fprintf(&sb, "%s is playing now: %s, %s %d-%d (%s)\n", g.Team().Name, opponent,
+			g.Team().Abbreviation, g.Team().Score, g.Opponent().Score, g.Game.StatusText)
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "%s next play %s\n", g.Team().Name, f.render(f.recordStyle(), opponent))
+	fmt.Fprintf(&sb, "  %s, %s\n", g.Week.Label(), f.kickoff(g.Game))
+	if !g.Game.StartTime.IsZero() {
+		fmt.Fprintf(&sb, "  Kickoff %s\n", untilKickoff(g.Game.StartTime.Sub(now)))
+	}
+	return sb.String()
+}
+
+// teamGameRow is one schedule line: week, kickoff or score, opponent
+func (f *TerminalFormatter) teamGameRow(g models.TeamGame) string {
+	opponent := "vs " + g.Opponent().Abbreviation
+	if !g.Home {
+		opponent = "@  " + g.Opponent().Abbreviation
+	}
+
+	var result string
+	switch g.Game.Status {
+	case models.StatusFinal:
+		res := g.Result()
+		result = fmt.Sprintf("%s %d-%d", res, g.Team().Score, g.Opponent().Score)
+		if !f.plain {
+			color := lipgloss.Color("40")
+			switch res {
+			case "L":
+				color = lipgloss.Color("196")
+			case "T":
+				color = lipgloss.Color("226")
+			}
+			result = lipgloss.NewStyle().Bold(true).Foreground(color).Render(result)
+		}
+	case models.StatusInProgress:
+		result = fmt.Sprintf("LIVE %d-%d  %s", g.Team().Score, g.Opponent().Score, g.Game.StatusText)
+		if !f.plain {
+			result = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("196")).Render(result)
+		}
+	}
+
+	return fmt.Sprintf("  %-11s %-22s %-7s %-22s %s", g.Week.Label(), f.kickoff(g.Game), opponent, truncate(g.Opponent().Name, 22), result)
+}
+
+// kickoff formats a game's start in the formatter's zone (local by default)
+func (f *TerminalFormatter) kickoff(g models.Game) string {
+	if g.StartTime.IsZero() {
+		return "TBD"
+	}
+	return g.StartTime.In(f.zone()).Format(kickoffLayout)
+}
+
+// untilKickoff describes how far away a kickoff is
+func untilKickoff(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "any minute now"
+	case d < time.Hour:
+		return fmt.Sprintf("in %d min", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("in %dh %02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("in %d days", int(d.Hours()/24))
+}
+
+func (f *TerminalFormatter) teamHeader(name, title string) string {
+	text := fmt.Sprintf("%s  ·  %s", strings.ToUpper(name), title)
+	if f.plain {
+		line := strings.Repeat("=", 76)
+		return "\n" + line + "\n  " + text + "\n" + line + "\n\n"
+	}
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("39")).
+		Background(lipgloss.Color("235")).
+		Padding(0, 2).
+		Width(76).
+		Render(text)
+	border := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(strings.Repeat("━", 76))
+	return "\n" + border + "\n" + header + "\n" + border + "\n\n"
+}
+
+func (f *TerminalFormatter) teamFooter() string {
+	if f.plain {
+		return "\n" + strings.Repeat("=", 76) + "\n"
+	}
+	return "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(strings.Repeat("━", 76)) + "\n"
+}
+
+func (f *TerminalFormatter) dimStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+}
+
+func (f *TerminalFormatter) recordStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("226"))
+}
+
+// render applies style unless the formatter is plain
+func (f *TerminalFormatter) render(style lipgloss.Style, s string) string {
+	if f.plain {
+		return s
+	}
+	return style.Render(s)
+}
+
+// teamName is the team's full name from its schedule, or the abbreviation
+func teamName(abbr string, schedule []models.TeamGame) string {
+	if len(schedule) > 0 {
+		return schedule[0].Team().Name
+	}
+	return abbr
+}
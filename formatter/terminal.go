@@ -2,7 +2,9 @@ package formatter
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"nfl-scores/models"
 
@@ -16,12 +18,21 @@ const (
 	iconClock    = ""  // nf-fa-clock_o
 	iconCheck    = ""  // nf-fa-check
 	iconAt       = "@"
+	iconStar     = "★"
 )
 
+// kickoffStatusLayout shows an upcoming kickoff, e.g. "Mon 1/6 3:04 PM EST"
+const kickoffStatusLayout = "Mon 1/2 3:04 PM MST"
+
+// statusWidth fits the longest kickoff, e.g. "Wed 12/31 12:00 PM EST"
+const statusWidth = 22
+
 // TerminalFormatter handles terminal output formatting
 type TerminalFormatter struct {
-	width int
-	plain bool
+	width     int
+	plain     bool
+	favorites []string       // Team abbreviations pinned to the top, most favorite first
+	location  *time.Location // Zone for kickoff times; nil for the system zone
 }
 
 // NewTerminalFormatter creates a formatter with specified width
@@ -32,8 +43,21 @@ func NewTerminalFormatter(width int, plain bool) *TerminalFormatter {
 	return &TerminalFormatter{width: width, plain: plain}
 }
 
+// WithFavorites pins and highlights games involving these teams
+func (f *TerminalFormatter) WithFavorites(teams []string) *TerminalFormatter {
+	f.favorites = teams
+	return f
+}
+
+// WithLocation shows upcoming kickoffs in loc
+func (f *TerminalFormatter) WithLocation(loc *time.Location) *TerminalFormatter {
+	f.location = loc
+	return f
+}
+
 // FormatScoreboard renders games as formatted terminal output
 func (f *TerminalFormatter) FormatScoreboard(games []models.Game) string {
+	games = f.pinFavorites(games)
 	if f.plain {
 		return f.formatPlain(games)
 	}
@@ -56,12 +80,16 @@ func (f *TerminalFormatter) formatPlain(games []models.Game) string {
 	for _, game := range games {
 		awayName := truncate(game.AwayTeam.Name, 18)
 		homeName := truncate(game.HomeTeam.Name, 18)
-		status := truncate(game.StatusText, 12)
-		if status == "" {
-			status = game.Status.String()
+		status := truncate(f.statusText(game), statusWidth)
+		marker := " "
+		if f.favoriteRank(game) >= 0 {
+			marker = "*"
+		}
+		sb.WriteString(fmt.Sprintf("%s %-18s %3d  @  %-18s %3d  [%-12s]\n",
+			marker, awayName, game.AwayTeam.Score, homeName, game.HomeTeam.Score, status))
+		for _, details := range gameDetails(game) {
+			sb.WriteString("      " + details + "\n")
 		}
-		sb.WriteString(fmt.Sprintf("  %-18s %3d  @  %-18s %3d  [%-12s]\n",
-			awayName, game.AwayTeam.Score, homeName, game.HomeTeam.Score, status))
 	}
 
 	sb.WriteString("\n" + line + "\n")
@@ -105,6 +133,13 @@ func (f *TerminalFormatter) formatStyled(games []models.Game) string {
 	finalStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("40"))
 
+	favoriteStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("214"))
+
+	detailStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("245"))
+
 	var sb strings.Builder
 	border := borderStyle.Render(strings.Repeat("━", 76))
 
@@ -123,10 +158,7 @@ func (f *TerminalFormatter) formatStyled(games []models.Game) string {
 
 		// Format status with icon
 		var statusStr string
-		statusText := truncate(game.StatusText, 12)
-		if statusText == "" {
-			statusText = game.Status.String()
-		}
+		statusText := truncate(f.statusText(game), statusWidth)
 
 		switch game.Status {
 		case models.StatusInProgress:
@@ -137,22 +169,127 @@ func (f *TerminalFormatter) formatStyled(games []models.Game) string {
 			statusStr = scheduledStyle.Render(iconClock + " " + statusText)
 		}
 
+		// Highlight favorite teams and mark their games
+		marker := " "
+		awayStyle, homeStyle := teamStyle, teamStyle
+		if f.favoriteRank(game) >= 0 {
+			marker = favoriteStyle.Render(iconStar)
+			if f.isFavorite(game.AwayTeam.Abbreviation) {
+				awayStyle = favoriteStyle
+			}
+			if f.isFavorite(game.HomeTeam.Abbreviation) {
+				homeStyle = favoriteStyle
+			}
+		}
+
 		// Build line
-		line := fmt.Sprintf("  %s %s  %s  %s %s  %s",
-			teamStyle.Render(fmt.Sprintf("%-18s", awayName)),
+		line := fmt.Sprintf("%s %s %s  %s  %s %s  %s",
+			marker,
+			awayStyle.Render(fmt.Sprintf("%-18s", awayName)),
 			awayScore,
 			lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(iconAt),
-			teamStyle.Render(fmt.Sprintf("%-18s", homeName)),
+			homeStyle.Render(fmt.Sprintf("%-18s", homeName)),
 			homeScore,
 			statusStr,
 		)
 		sb.WriteString(line + "\n")
+		for _, details := range gameDetails(game) {
+			sb.WriteString("      " + detailStyle.Render(details) + "\n")
+		}
 	}
 
 	sb.WriteString("\n" + border + "\n")
 	return sb.String()
 }
 
+// gameDetails are the lines under a game: records, TV, venue and the
+// betting line before kickoff, and how the line settled after the final
+func gameDetails(game models.Game) []string {
+	var lines []string
+	if details := upcomingDetails(game); details != "" {
+		lines = append(lines, details)
+	}
+	if line := lineDetails(game); line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// upcomingDetails is the line under a scheduled game: records, TV and venue
+func upcomingDetails(game models.Game) string {
+	if game.Status != models.StatusScheduled {
+		return ""
+	}
+	var parts []string
+	if game.AwayTeam.Record != "" || game.HomeTeam.Record != "" {
+		parts = append(parts, fmt.Sprintf("%s %s @ %s %s",
+			game.AwayTeam.Abbreviation, game.AwayTeam.Record, game.HomeTeam.Abbreviation, game.HomeTeam.Record))
+	}
+	if game.Broadcast != "" {
+		parts = append(parts, "TV: "+game.Broadcast)
+	}
+	if venue := game.Venue.Location(); venue != "" {
+		parts = append(parts, venue)
+	}
+	return truncate(strings.Join(parts, " · "), 70)
+}
+
+// statusText is ESPN's status, with upcoming kickoffs shown as a date and
+// time in the kickoff zone
+func (f *TerminalFormatter) statusText(game models.Game) string {
+	if game.Status == models.StatusScheduled && !game.StartTime.IsZero() {
+		return game.StartTime.In(f.zone()).Format(kickoffStatusLayout)
+	}
+	if game.StatusText == "" {
+		return game.Status.String()
+	}
+	return game.StatusText
+}
+
+// zone is where kickoff times are shown
+func (f *TerminalFormatter) zone() *time.Location {
+	if f.location != nil {
+		return f.location
+	}
+	return time.Local
+}
+
+// pinFavorites moves games involving a favorite team to the top, ordered
+// by how favorite the team is, keeping ESPN's order otherwise
+func (f *TerminalFormatter) pinFavorites(games []models.Game) []models.Game {
+	if len(f.favorites) == 0 {
+		return games
+	}
+	sorted := append([]models.Game(nil), games...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ri, rj := f.favoriteRank(sorted[i]), f.favoriteRank(sorted[j])
+		if ri < 0 || rj < 0 {
+			return ri >= 0 && rj < 0
+		}
+		return ri < rj
+	})
+	return sorted
+}
+
+// favoriteRank is the best favorites position of either team, or -1
+func (f *TerminalFormatter) favoriteRank(game models.Game) int {
+	for i, team := range f.favorites {
+		if strings.EqualFold(team, game.AwayTeam.Abbreviation) || strings.EqualFold(team, game.HomeTeam.Abbreviation) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *TerminalFormatter) isFavorite(abbr string) bool {
+	for _, team := range f.favorites {
+		if strings.EqualFold(team, abbr) {
+			return true
+		}
+	}
+	return false
+}
+
 // truncate shortens a string to max length
 func truncate(s string, max int) string {
 	if len(s) <= max {
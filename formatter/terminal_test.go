@@ -0,0 +1,72 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"nfl-scores/models"
+)
+
+func TestStatusText(t *testing.T) {
+	load := func(name string) *time.Location {
+		t.Helper()
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return loc
+	}
+	eastern, central := load("America/New_York"), load("America/Chicago")
+
+	tests := []struct {
+		name string
+		game models.Game
+		zone *time.Location
+		want string
+	}{
+		{
+			"kickoff in standard time",
+			models.Game{Status: models.StatusScheduled, StartTime: time.Date(2025, 1, 6, 20, 4, 0, 0, time.UTC)},
+			eastern, "Mon 1/6 3:04 PM EST",
+		},
+		{
+			"kickoff in daylight time",
+			models.Game{Status: models.StatusScheduled, StartTime: time.Date(2024, 9, 8, 17, 0, 0, 0, time.UTC)},
+			eastern, "Sun 9/8 1:00 PM EDT",
+		},
+		{
+			"kickoff on another day in the viewer's zone",
+			models.Game{Status: models.StatusScheduled, StartTime: time.Date(2024, 12, 31, 4, 15, 0, 0, time.UTC)},
+			central, "Mon 12/30 10:15 PM CST",
+		},
+		{"scheduled without a time", models.Game{Status: models.StatusScheduled, StatusText: "TBD"}, eastern, "TBD"},
+		{"in progress", models.Game{Status: models.StatusInProgress, StatusText: "Q3 8:12"}, eastern, "Q3 8:12"},
+		{"final", models.Game{Status: models.StatusFinal, StatusText: "Final/OT"}, eastern, "Final/OT"},
+	}
+	for _, tt := range tests {
+		f := NewTerminalFormatter(80, true)
+		f.WithLocation(tt.zone)
+		if got := f.statusText(tt.game); got != tt.want {
+			t.Errorf("%s: statusText = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestScoreboardShowsWholeKickoff(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := NewTerminalFormatter(80, true)
+	f.WithLocation(loc)
+	game := models.Game{
+		Status:    models.StatusScheduled,
+		StartTime: time.Date(2025, 1, 1, 17, 0, 0, 0, time.UTC),
+		AwayTeam:  models.Team{Name: "Kansas City Chiefs", Abbreviation: "KC"},
+		HomeTeam:  models.Team{Name: "Buffalo Bills", Abbreviation: "BUF"},
+	}
+	if got := f.FormatScoreboard([]models.Game{game}); !strings.Contains(got, "[Wed 1/1 12:00 PM EST]") {
+		t.Errorf("scoreboard cut the kickoff short:\n%s", got)
+	}
+}
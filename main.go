@@ -1,16 +1,19 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"flag"
 	"fmt"
 	"os"
-	"strconv"
-	"strings"
+	"os/signal"
+	"time"
 
+	"nfl-scores/calendar"
 	"nfl-scores/client"
+	"nfl-scores/config"
 	"nfl-scores/formatter"
 	"nfl-scores/models"
+	"nfl-scores/output"
 	"nfl-scores/service"
 	"nfl-scores/ui"
 
@@ -21,38 +24,162 @@ const helpText = `NFL Scores CLI - Display current NFL game scores
 
 Usage:
   nfl-scores [options]
+  nfl-scores fake-espn [--addr ADDR] [--fixtures DIR] [--live ID]
+  nfl-scores cache clear|stats
+  nfl-scores serve [--addr :8080] [--base-url URL]
+  nfl-scores push-server [--addr :8081] [--allow-origin ORIGINS] [--base-url URL]
+  nfl-scores webhooks [--config FILE] [--game ID] [--log FILE] [--test]
+  nfl-scores config get [KEY] | set KEY VALUE | path
+  nfl-scores team ABBR [schedule|results|record|next] [--season YEAR]
 
 Options:
-  -h, --help      Show this help message
-  --plain         Disable colors and icons (for basic terminals)
-  --watch         Watch a live game with play-by-play updates
-  --replay        Replay a completed game play-by-play
-  --stats         Show detailed game statistics (box score)
-  --game ID       Specify game ID directly
-  --dates RANGE   Date range for historical games (format: YYYYMMDD-YYYYMMDD)
-  --mascot        Show animated mascot with team colors
+  -h, --help         Show this help message
+  --plain            Disable colors and icons (for basic terminals; default from config)
+  --watch            Watch a live game with play-by-play updates (a favorite team's, if one is playing)
+  --dashboard        Follow every live game at once as a grid of cards
+  --replay           Replay a completed game play-by-play
+  --stats            Show detailed game statistics (box score)
+  --ats              Records against the spread and over/under for --dates or a week
+  --game ID          Specify game ID directly
+  --dates RANGE      Date range for historical games or the game picker (format: YYYYMMDD-YYYYMMDD)
+  --week N           NFL week instead of --dates (1-18; 0-3 with --preseason, 0 = Hall of Fame)
+  --season YEAR      Season for --week or --postseason (default: current season)
+  --preseason        With --week, a preseason week
+  --postseason       The whole postseason, or one round with --round
+  --round NAME       wildcard, divisional, conference, probowl or superbowl
+  --last-week        The previous NFL week
+  --next-week        The next NFL week
+  --mascot           Show animated mascot with team colors (default from config)
+  --tz ZONE          Show kickoff times in ZONE, e.g. America/Denver (default from config, then system)
+  --base-url URL     Use an alternate ESPN-compatible API (e.g. fake-espn)
+  --record DIR       Save every API response under DIR
+  --playback DIR     Serve API responses recorded with --record from DIR
+  --no-cache         Bypass the on-disk response cache
+  --interval D       Baseline live poll interval (default 10s)
+  --min-interval D   Fastest live poll interval, used in two-minute drills (default 3s)
+  --max-interval D   Slowest live poll interval, used at breaks and finals (default 60s)
+  --events           With --watch --game, print one JSON line per game event
+  --output FORMAT    text (default), json, yaml, csv or ndjson; see docs/output-schema.md
 
 Examples:
   nfl-scores                          Display current NFL scores
   nfl-scores --dates 20241201-20241208  Show games from Dec 1-8, 2024
+  nfl-scores --season 2024 --week 3   Show week 3 of the 2024 season
+  nfl-scores --postseason --round divisional --season 2024
+  nfl-scores --replay --last-week     Pick a game from last week to replay
   nfl-scores --stats                  View stats for a game
+  nfl-scores --ats --season 2024 --postseason  Playoff records against the spread
   nfl-scores --stats --game ID        Stats for specific game
   nfl-scores --replay                 Select and replay a completed game
   nfl-scores --watch --mascot         Watch live game with mascot
+  nfl-scores --dashboard              Follow all live games at once
+  nfl-scores --output json            Scoreboard as JSON
+  nfl-scores --stats --game ID --output csv  Box score as CSV
+  nfl-scores --watch --game ID --events  Stream scoring plays, turnovers, etc. as NDJSON
+  nfl-scores --record ./sunday --watch Record a live game while watching it
+  nfl-scores --playback ./sunday --watch --game ID  Re-watch a recorded game offline
+  nfl-scores fake-espn --live 401671001   Serve fixtures with a scripted live game
+  nfl-scores --base-url http://127.0.0.1:8089 --watch --game 401671001
   nfl-scores -h                       Show help
 `
 
+// clientFlags pick where ESPN data comes from; every command shares them
+type clientFlags struct {
+	baseURL     string
+	noCache     bool
+	recordDir   string
+	playbackDir string
+}
+
+// addClientFlags registers --base-url and --no-cache on fs
+func addClientFlags(fs *flag.FlagSet) *clientFlags {
+	cf := &clientFlags{}
+	fs.StringVar(&cf.baseURL, "base-url", "", "Alternate ESPN-compatible API base URL")
+	fs.BoolVar(&cf.noCache, "no-cache", false, "Bypass the on-disk response cache")
+	return cf
+}
+
+// newESPNClient builds the ESPN client described by cf
+func newESPNClient(cf *clientFlags) *client.ESPNClient {
+	var opts []client.Option
+	if cf.baseURL != "" {
+		opts = append(opts, client.WithBaseURL(cf.baseURL))
+	}
+	if cf.recordDir != "" {
+		opts = append(opts, client.WithRecording(cf.recordDir))
+	}
+	if cf.playbackDir != "" {
+		opts = append(opts, client.WithPlayback(cf.playbackDir))
+	}
+	// Recording and playback must see every request, so they skip the cache
+	if !cf.noCache && cf.recordDir == "" && cf.playbackDir == "" {
+		if dir, err := client.DefaultCacheDir(); err == nil {
+			opts = append(opts, client.WithCache(client.NewCache(dir)))
+		}
+	}
+	return client.NewESPNClient(opts...)
+}
+
 func main() {
+	// Subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "fake-espn":
+			runFakeESPN(os.Args[2:])
+			return
+		case "cache":
+			runCacheCommand(os.Args[2:])
+			return
+		case "serve":
+			runServe(os.Args[2:])
+			return
+		case "push-server":
+			runPushServer(os.Args[2:])
+			return
+		case "webhooks":
+			runWebhooks(os.Args[2:])
+			return
+		case "config":
+			runConfigCommand(os.Args[2:])
+			return
+		case "team":
+			runTeamCommand(os.Args[2:])
+			return
+		}
+	}
+
+	// Defaults from the config file; flags override them
+	userConfig := loadUserConfig()
+
 	// Parse flags
 	help := flag.Bool("h", false, "Show help")
 	flag.BoolVar(help, "help", false, "Show help")
-	plain := flag.Bool("plain", false, "Disable colors and icons")
+	plain := flag.Bool("plain", userConfig.Plain, "Disable colors and icons")
 	watch := flag.Bool("watch", false, "Watch a live game")
+	dashboard := flag.Bool("dashboard", false, "Show all live games as a dashboard")
 	replay := flag.Bool("replay", false, "Replay a completed game")
 	showStats := flag.Bool("stats", false, "Show game statistics")
+	ats := flag.Bool("ats", false, "Report records against the spread and the total")
 	gameID := flag.String("game", "", "Game ID to watch or replay")
 	dates := flag.String("dates", "", "Date range (YYYYMMDD-YYYYMMDD)")
-	mascot := flag.Bool("mascot", false, "Show animated mascot")
+	var weekSel weekSelection
+	flag.IntVar(&weekSel.week, "week", -1, "NFL week (1-18, or 0-3 with --preseason)")
+	flag.IntVar(&weekSel.season, "season", 0, "Season year for --week/--postseason (default: current)")
+	flag.BoolVar(&weekSel.preseason, "preseason", false, "With --week, a preseason week")
+	flag.BoolVar(&weekSel.postseason, "postseason", false, "The playoffs, or one round with --round")
+	flag.StringVar(&weekSel.round, "round", "", "Postseason round: wildcard, divisional, conference, superbowl")
+	flag.BoolVar(&weekSel.last, "last-week", false, "The previous NFL week")
+	flag.BoolVar(&weekSel.next, "next-week", false, "The next NFL week")
+	mascot := flag.Bool("mascot", userConfig.Mascot, "Show animated mascot")
+	tz := flag.String("tz", "", "Time zone for kickoff times (IANA name; default from config, then the system zone)")
+	espn := addClientFlags(flag.CommandLine)
+	flag.StringVar(&espn.recordDir, "record", "", "Record API responses to directory")
+	flag.StringVar(&espn.playbackDir, "playback", "", "Play back API responses from directory")
+	interval := flag.Duration("interval", service.DefaultPollInterval, "Baseline live poll interval")
+	minInterval := flag.Duration("min-interval", service.DefaultMinPollInterval, "Fastest live poll interval")
+	maxInterval := flag.Duration("max-interval", service.DefaultMaxPollInterval, "Slowest live poll interval")
+	events := flag.Bool("events", false, "Print live game events as NDJSON instead of the TUI")
+	outputFlag := flag.String("output", "text", "Output format: text, json, yaml, csv or ndjson")
 	flag.Parse()
 
 	if *help {
@@ -60,28 +187,108 @@ func main() {
 		os.Exit(0)
 	}
 
+	format, err := output.ParseFormat(*outputFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if weekDates, ok, err := weekSel.dates(time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	} else if ok {
+		if *dates != "" {
+			fmt.Fprintln(os.Stderr, "Error: --dates cannot be combined with week selection flags")
+			os.Exit(1)
+		}
+		*dates = weekDates
+	}
+
+	// Only --interval given: stretch the default bounds around it
+	setFlags := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+	if !setFlags["min-interval"] && *minInterval > *interval {
+		*minInterval = *interval
+	}
+	if !setFlags["max-interval"] && *maxInterval < *interval {
+		*maxInterval = *interval
+	}
+
+	pollConfig := service.PollConfig{Interval: *interval, MinInterval: *minInterval, MaxInterval: *maxInterval}
+	if pollConfig.MinInterval <= 0 || pollConfig.MinInterval > pollConfig.Interval || pollConfig.Interval > pollConfig.MaxInterval {
+		fmt.Fprintln(os.Stderr, "Error: poll intervals must satisfy 0 < --min-interval <= --interval <= --max-interval")
+		os.Exit(1)
+	}
+
 	// Initialize components
-	espnClient := client.NewESPNClient()
-	scoreService := service.NewScoreService(espnClient)
-	termFormatter := formatter.NewTerminalFormatter(80, *plain)
+	if espn.recordDir != "" && espn.playbackDir != "" {
+		fmt.Fprintln(os.Stderr, "Error: --record and --playback cannot be used together")
+		os.Exit(1)
+	}
+	if *events && (!*watch || *gameID == "") {
+		fmt.Fprintln(os.Stderr, "Error: --events needs --watch --game ID")
+		os.Exit(1)
+	}
+
+	// Cancel in-flight requests and retries on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	scoreService := service.NewScoreService(newESPNClient(espn))
+	termFormatter := formatter.NewTerminalFormatter(80, *plain).WithFavorites(userConfig.Favorites)
+	termFormatter.WithLocation(kickoffZone(*tz, userConfig))
+
+	if *events {
+		if err := runEventsMode(ctx, scoreService, *gameID, pollConfig); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *ats {
+		if format != output.FormatText {
+			fmt.Fprintln(os.Stderr, "Error: --ats only has text output")
+			os.Exit(1)
+		}
+		runATSMode(ctx, scoreService, termFormatter, *dates)
+		return
+	}
+
+	if format != output.FormatText {
+		if err := runOutputMode(ctx, scoreService, format, *showStats, *replay, *watch, *gameID, *dates); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	if *showStats {
-		runStatsMode(scoreService, termFormatter, *gameID, *dates)
+		runStatsMode(ctx, scoreService, termFormatter, *gameID, *dates, *plain)
 		return
 	}
 
 	if *replay {
-		runReplayMode(scoreService, termFormatter, *gameID, *dates, *plain, *mascot)
+		runReplayMode(scoreService, *gameID, *dates, *plain, *mascot)
+		return
+	}
+
+	if *dashboard {
+		runDashboardMode(scoreService, *plain, *mascot, pollConfig)
 		return
 	}
 
 	if *watch {
-		runWatchMode(scoreService, termFormatter, *gameID, *plain, *mascot)
+		// Go straight to a favorite team's game when one is on
+		if *gameID == "" && *dates == "" {
+			*gameID = favoriteLiveGame(ctx, scoreService, userConfig)
+		}
+		runWatchMode(scoreService, *gameID, *dates, *plain, *mascot, pollConfig)
 		return
 	}
 
 	// Default: show all scores
-	games, err := scoreService.GetScoresByDates(*dates)
+	games, err := scoreService.GetScoresByDates(ctx, *dates)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, termFormatter.FormatError(err))
 		os.Exit(1)
@@ -91,44 +298,91 @@ func main() {
 	os.Exit(0)
 }
 
-func runWatchMode(svc *service.ScoreService, f *formatter.TerminalFormatter, gameID string, plain bool, mascot bool) {
-	// If no game ID provided, let user select from live games
-	if gameID == "" {
-		games, err := svc.GetLiveGames()
-		if err != nil {
-			fmt.Fprintln(os.Stderr, f.FormatError(err))
-			os.Exit(1)
-		}
+// weekSelection holds the week-based alternatives to --dates
+type weekSelection struct {
+	week       int // -1 when unset
+	season     int // 0 for the current season
+	preseason  bool
+	postseason bool
+	round      string
+	last, next bool
+}
 
-		if len(games) == 0 {
-			fmt.Println("No live games available right now. Try again during game time.")
-			os.Exit(0)
+// dates resolves the selection to a scoreboard range; ok is false when no
+// week flag was given
+func (s weekSelection) dates(now time.Time) (string, bool, error) {
+	modes := 0
+	for _, set := range []bool{s.week >= 0, s.postseason || s.round != "", s.last, s.next} {
+		if set {
+			modes++
+		}
+	}
+	switch {
+	case modes == 0:
+		if s.season != 0 || s.preseason {
+			return "", false, fmt.Errorf("--season and --preseason need --week or --postseason")
 		}
+		return "", false, nil
+	case modes > 1:
+		return "", false, fmt.Errorf("use only one of --week, --postseason/--round, --last-week and --next-week")
+	case (s.last || s.next) && (s.season != 0 || s.preseason):
+		return "", false, fmt.Errorf("--last-week and --next-week are relative to today and can't take --season")
+	}
 
-		fmt.Print(f.FormatGameSelection(games))
+	season := s.season
+	if season == 0 {
+		season = calendar.SeasonOf(now)
+	}
 
-		// Read user selection
-		reader := bufio.NewReader(os.Stdin)
-		input, _ := reader.ReadString('\n')
-		input = strings.TrimSpace(input)
+	var week calendar.Week
+	var err error
+	switch {
+	case s.last:
+		week = calendar.Current(now).Prev()
+	case s.next:
+		week = calendar.Current(now).Next()
+	case s.week >= 0 && s.preseason:
+		week, err = calendar.PreseasonWeek(season, s.week)
+	case s.week >= 0:
+		week, err = calendar.RegularWeek(season, s.week)
+	case s.preseason:
+		return "", false, fmt.Errorf("--preseason can't be combined with --postseason")
+	case s.round == "":
+		return calendar.PostseasonDates(season), true, nil
+	default:
+		var round int
+		if round, err = calendar.ParseRound(s.round); err == nil {
+			week, err = calendar.PostseasonRound(season, round)
+		}
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return week.Dates(), true, nil
+}
 
-		num, err := strconv.Atoi(input)
-		if err != nil || num < 1 || num > len(games) {
-			fmt.Println("Invalid selection.")
-			os.Exit(1)
+func runWatchMode(svc *service.ScoreService, gameID string, dates string, plain bool, mascot bool, pollConfig service.PollConfig) {
+	newModel := func(id string) ui.Model {
+		if mascot {
+			return ui.NewModelWithMascot(id, svc, plain).WithPollConfig(pollConfig)
 		}
+		return ui.NewModel(id, svc, plain).WithPollConfig(pollConfig)
+	}
 
-		gameID = games[num-1].ID
+	// If no game ID provided, let user select from live games
+	if gameID == "" {
+		runPicker(svc, plain, ui.PickerConfig{
+			Title:       "Select a live game to watch",
+			Dates:       dates,
+			Selectable:  func(g models.Game) bool { return g.Status == models.StatusInProgress },
+			Unavailable: "Only games in progress can be watched live.",
+			Launch:      func(id string) tea.Model { return newModel(id) },
+		})
+		return
 	}
 
 	// Run Bubble Tea UI with mouse support
-	var model ui.Model
-	if mascot {
-		model = ui.NewModelWithMascot(gameID, svc, plain)
-	} else {
-		model = ui.NewModel(gameID, svc, plain)
-	}
-	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
+	p := tea.NewProgram(newModel(gameID), tea.WithAltScreen(), tea.WithMouseCellMotion())
 
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running UI: %v\n", err)
@@ -136,58 +390,54 @@ func runWatchMode(svc *service.ScoreService, f *formatter.TerminalFormatter, gam
 	}
 }
 
-func runReplayMode(svc *service.ScoreService, f *formatter.TerminalFormatter, gameID string, dates string, plain bool, mascot bool) {
-	// If no game ID provided, let user select from completed games
-	if gameID == "" {
-		games, err := svc.GetScoresByDates(dates)
-		if err != nil {
-			fmt.Fprintln(os.Stderr, f.FormatError(err))
-			os.Exit(1)
-		}
+// favoriteLiveGame returns the in-progress game of the most favorite team
+// playing, or "" when none is
+func favoriteLiveGame(ctx context.Context, svc *service.ScoreService, cfg config.Config) string {
+	if len(cfg.Favorites) == 0 {
+		return ""
+	}
+	games, err := svc.GetLiveGames(ctx)
+	if err != nil {
+		return "" // The picker will report it
+	}
 
-		// Filter to completed games only
-		var completed []models.Game
-		for _, g := range games {
-			if g.Status == models.StatusFinal {
-				completed = append(completed, g)
+	best, bestRank := "", len(cfg.Favorites)
+	for _, g := range games {
+		for _, abbr := range []string{g.AwayTeam.Abbreviation, g.HomeTeam.Abbreviation} {
+			if rank := cfg.FavoriteRank(abbr); rank >= 0 && rank < bestRank {
+				best, bestRank = g.ID, rank
 			}
 		}
+	}
+	return best
+}
 
-		if len(completed) == 0 {
-			fmt.Println("No completed games available for replay.")
-			os.Exit(0)
-		}
-
-		dateInfo := ""
-		if dates != "" {
-			dateInfo = fmt.Sprintf(" (%s)", dates)
-		}
-		fmt.Printf("\nSelect a completed game to replay%s:\n", dateInfo)
-		fmt.Println()
-		for i, g := range completed {
-			fmt.Printf("  [%d] %s %d @ %s %d (Final)\n",
-				i+1, g.AwayTeam.Abbreviation, g.AwayTeam.Score,
-				g.HomeTeam.Abbreviation, g.HomeTeam.Score)
-		}
-		fmt.Print("\nEnter number: ")
-
-		// Read user selection
-		reader := bufio.NewReader(os.Stdin)
-		input, _ := reader.ReadString('\n')
-		input = strings.TrimSpace(input)
+func runDashboardMode(svc *service.ScoreService, plain bool, mascot bool, pollConfig service.PollConfig) {
+	model := ui.NewDashboardModel(svc, plain, mascot, pollConfig)
+	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
 
-		num, err := strconv.Atoi(input)
-		if err != nil || num < 1 || num > len(completed) {
-			fmt.Println("Invalid selection.")
-			os.Exit(1)
-		}
+	if _, err := p.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running dashboard: %v\n", err)
+		os.Exit(1)
+	}
+}
 
-		gameID = completed[num-1].ID
+func runReplayMode(svc *service.ScoreService, gameID string, dates string, plain bool, mascot bool) {
+	// If no game ID provided, let user select from completed games
+	if gameID == "" {
+		runPicker(svc, plain, ui.PickerConfig{
+			Title:       "Select a completed game to replay",
+			Dates:       dates,
+			Selectable:  func(g models.Game) bool { return g.Status == models.StatusFinal },
+			Unavailable: "Only completed games can be replayed.",
+			Launch:      func(id string) tea.Model { return ui.NewReplayModel(id, svc, plain, mascot) },
+		})
+		return
 	}
 
 	// Run replay UI
 	model := ui.NewReplayModel(gameID, svc, plain, mascot)
-	p := tea.NewProgram(model, tea.WithAltScreen())
+	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
 
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running replay: %v\n", err)
@@ -195,57 +445,100 @@ func runReplayMode(svc *service.ScoreService, f *formatter.TerminalFormatter, ga
 	}
 }
 
-func runStatsMode(svc *service.ScoreService, f *formatter.TerminalFormatter, gameID string, dates string) {
-	// If no game ID provided, let user select a game
+func runStatsMode(ctx context.Context, svc *service.ScoreService, f *formatter.TerminalFormatter, gameID string, dates string, plain bool) {
+	// If no game ID provided, let user select a game and browse its stats in the TUI
 	if gameID == "" {
-		games, err := svc.GetScoresByDates(dates)
-		if err != nil {
-			fmt.Fprintln(os.Stderr, f.FormatError(err))
-			os.Exit(1)
-		}
+		runPicker(svc, plain, ui.PickerConfig{
+			Title:  "Select a game to view stats",
+			Dates:  dates,
+			Launch: func(id string) tea.Model { return ui.NewStatsModel(id, svc, plain) },
+		})
+		return
+	}
 
-		if len(games) == 0 {
-			fmt.Println("No games available.")
-			os.Exit(0)
-		}
+	// Fetch and display stats
+	stats, err := svc.GetGameStats(ctx, gameID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, f.FormatError(err))
+		os.Exit(1)
+	}
 
-		dateInfo := ""
-		if dates != "" {
-			dateInfo = fmt.Sprintf(" (%s)", dates)
-		}
-		fmt.Printf("\nSelect a game to view stats%s:\n", dateInfo)
-		fmt.Println()
-		for i, g := range games {
-			status := g.StatusText
-			if status == "" {
-				status = g.Status.String()
+	fmt.Print(f.FormatGameStats(stats))
+}
+
+// runATSMode prints each team's record against the spread and the total
+// for the games in a date range (the current scoreboard by default)
+func runATSMode(ctx context.Context, svc *service.ScoreService, f *formatter.TerminalFormatter, dates string) {
+	report, err := svc.GetATSReport(ctx, dates)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, f.FormatError(err))
+		os.Exit(1)
+	}
+
+	label := "Current scoreboard"
+	if dates != "" {
+		label = dates
+		if start, err := time.Parse("20060102", dates[:min(8, len(dates))]); err == nil {
+			if w, ok := calendar.WeekOf(start); ok && w.Dates() == dates {
+				label = fmt.Sprintf("%d %s", w.Season, w.Label())
 			}
-			fmt.Printf("  [%d] %s %d @ %s %d (%s)\n",
-				i+1, g.AwayTeam.Abbreviation, g.AwayTeam.Score,
-				g.HomeTeam.Abbreviation, g.HomeTeam.Score, status)
 		}
-		fmt.Print("\nEnter number: ")
+	}
+	fmt.Print(f.FormatATSReport(report, label))
+}
 
-		// Read user selection
-		reader := bufio.NewReader(os.Stdin)
-		input, _ := reader.ReadString('\n')
-		input = strings.TrimSpace(input)
+// runPicker shows the game picker; the chosen game opens in the same alt screen
+func runPicker(svc *service.ScoreService, plain bool, cfg ui.PickerConfig) {
+	p := tea.NewProgram(ui.NewPickerModel(svc, cfg, plain), tea.WithAltScreen(), tea.WithMouseCellMotion())
 
-		num, err := strconv.Atoi(input)
-		if err != nil || num < 1 || num > len(games) {
-			fmt.Println("Invalid selection.")
-			os.Exit(1)
-		}
+	if _, err := p.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running picker: %v\n", err)
+		os.Exit(1)
+	}
+}
 
-		gameID = games[num-1].ID
+// runOutputMode writes one machine-readable document instead of starting a UI:
+// the box score with --stats, every play with --replay, a live snapshot with
+// --watch, otherwise the scoreboard
+func runOutputMode(ctx context.Context, svc *service.ScoreService, format output.Format, stats, replay, live bool, gameID string, dates string) error {
+	if (stats || replay || live) && gameID == "" {
+		return fmt.Errorf("--output needs --game ID with --stats, --replay or --watch")
 	}
 
-	// Fetch and display stats
-	stats, err := svc.GetGameStats(gameID)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, f.FormatError(err))
-		os.Exit(1)
+	var doc output.Document
+	switch {
+	case stats:
+		s, err := svc.GetGameStats(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		doc = output.NewBoxScore(s)
+	case replay:
+		r, err := svc.GetGameReplay(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		doc = output.NewReplay(r)
+	case live:
+		s, err := svc.GetGameSummary(ctx, gameID)
+		if err != nil {
+			return err
+		}
+		doc = output.NewLiveSummary(s)
+	default:
+		games, err := svc.GetScoresByDates(ctx, dates)
+		if err != nil {
+			return err
+		}
+		doc = output.NewScoreboard(games)
 	}
+	return output.Write(os.Stdout, format, doc)
+}
 
-	fmt.Print(f.FormatGameStats(stats))
+// runEventsMode streams a live game's events to stdout as NDJSON until the
+// game ends or the user interrupts
+func runEventsMode(ctx context.Context, svc *service.ScoreService, gameID string, pollConfig service.PollConfig) error {
+	return svc.WatchEvents(ctx, gameID, pollConfig, func(e service.Event) error {
+		return output.WriteEvent(os.Stdout, e)
+	})
 }
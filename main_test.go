@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"nfl-scores/fakeespn"
+)
+
+func TestClientFlags(t *testing.T) {
+	srv, err := fakeespn.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	espn := addClientFlags(fs)
+	if err := fs.Parse([]string{"--base-url", srv.URL(), "--no-cache"}); err != nil {
+		t.Fatal(err)
+	}
+	if !espn.noCache || espn.baseURL != srv.URL() {
+		t.Fatalf("flags = %+v", *espn)
+	}
+
+	sb, err := newESPNClient(espn).FetchScoreboard(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sb.Events) == 0 {
+		t.Error("client didn't use --base-url: no games from the fake server")
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // GameStatus represents the current state of a game
 type GameStatus int
@@ -30,6 +33,7 @@ type Team struct {
 	Name         string
 	Abbreviation string
 	Score        int
+	Record       string // Season record before or including this game, e.g. "6-4"; scoreboard only
 }
 
 // Game represents a single NFL game
@@ -40,4 +44,33 @@ type Game struct {
 	Status     GameStatus
 	StatusText string
 	StartTime  time.Time
+	Venue      Venue
+	Broadcast  string   // TV networks, e.g. "CBS" or "ESPN, ABC"
+	Weather    *Weather // Forecast for outdoor games, when ESPN has one
+	Odds       *Odds    // Betting line, when ESPN has one
+}
+
+// Venue is the stadium a game is played in
+type Venue struct {
+	Name   string
+	City   string
+	State  string
+	Indoor bool
+}
+
+// Location formats the venue as "Name, City, ST"
+func (v Venue) Location() string {
+	parts := make([]string, 0, 3)
+	for _, s := range []string{v.Name, v.City, v.State} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Weather is the game-time forecast
+type Weather struct {
+	Summary     string // e.g. "Cloudy"
+	Temperature int    // Degrees Fahrenheit
 }
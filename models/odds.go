@@ -0,0 +1,172 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Odds is a sportsbook's line for a game
+type Odds struct {
+	Provider      string  // e.g. "ESPN BET"
+	Details       string  // ESPN's summary, e.g. "BUF -2.5"
+	Spread        float64 // The home team's line as ESPN reports it; see HomeLine
+	OverUnder     float64 // Total points line; 0 when there is none
+	AwayMoneyLine int     // American odds, e.g. -135 or +115; 0 when there is none
+	HomeMoneyLine int
+	AwayFavorite  bool
+	HomeFavorite  bool
+}
+
+// HasSpread reports whether the book set a point spread (a pick'em counts)
+func (o Odds) HasSpread() bool {
+	return o.Spread != 0 || o.Details != ""
+}
+
+// HomeLine is the home team's point spread: negative when the home team
+// is favored. The favorite flags win over the sign of Spread, which ESPN
+// has not always reported consistently.
+func (o Odds) HomeLine() float64 {
+	points := math.Abs(o.Spread)
+	switch {
+	case o.HomeFavorite:
+		return -points
+	case o.AwayFavorite:
+		return points
+	}
+	return o.Spread
+}
+
+// Favorite is the abbreviation of the team favored by the spread, or ""
+// for a pick'em or a game without a line
+func (g Game) Favorite() string {
+	if g.Odds == nil {
+		return ""
+	}
+	switch line := g.Odds.HomeLine(); {
+	case line < 0:
+		return g.HomeTeam.Abbreviation
+	case line > 0:
+		return g.AwayTeam.Abbreviation
+	}
+	return ""
+}
+
+// SpreadText is the line from the favorite's side, e.g. "BUF -2.5", or
+// "EVEN" for a pick'em; "" without a spread
+func (g Game) SpreadText() string {
+	if g.Odds == nil || !g.Odds.HasSpread() {
+		return ""
+	}
+	fav := g.Favorite()
+	if fav == "" {
+		return "EVEN"
+	}
+	return fmt.Sprintf("%s -%s", fav, FormatPoints(math.Abs(g.Odds.Spread)))
+}
+
+// MoneyLineText is both moneylines, e.g. "BUF -135 / KC +115"; "" when the
+// book has none
+func (g Game) MoneyLineText() string {
+	if g.Odds == nil || (g.Odds.AwayMoneyLine == 0 && g.Odds.HomeMoneyLine == 0) {
+		return ""
+	}
+	return fmt.Sprintf("%s %+d / %s %+d",
+		g.AwayTeam.Abbreviation, g.Odds.AwayMoneyLine, g.HomeTeam.Abbreviation, g.Odds.HomeMoneyLine)
+}
+
+// FormatPoints writes a spread or total without a trailing ".0"
+func FormatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
+
+// Total results
+const (
+	TotalOver  = "over"
+	TotalUnder = "under"
+	TotalPush  = "push"
+)
+
+// LineResult is how a finished game settled against its line
+type LineResult struct {
+	HasSpread bool
+	Covered   string // Abbreviation of the team that covered; "" on a push
+	Push      bool   // Final margin landed exactly on the spread
+	Total     int    // Combined points
+	TotalLine string // TotalOver, TotalUnder or TotalPush; "" without an over/under
+}
+
+// LineResult settles a final game against its line; ok is false for
+// games that aren't final or have no line
+func (g Game) LineResult() (r LineResult, ok bool) {
+	if g.Status != StatusFinal || g.Odds == nil {
+		return LineResult{}, false
+	}
+
+	r.Total = g.HomeTeam.Score + g.AwayTeam.Score
+	if g.Odds.HasSpread() {
+		r.HasSpread = true
+		switch margin := float64(g.HomeTeam.Score-g.AwayTeam.Score) + g.Odds.HomeLine(); {
+		case margin > 0:
+			r.Covered = g.HomeTeam.Abbreviation
+		case margin < 0:
+			r.Covered = g.AwayTeam.Abbreviation
+		default:
+			r.Push = true
+		}
+	}
+	if g.Odds.OverUnder > 0 {
+		switch total := float64(r.Total); {
+		case total > g.Odds.OverUnder:
+			r.TotalLine = TotalOver
+		case total < g.Odds.OverUnder:
+			r.TotalLine = TotalUnder
+		default:
+			r.TotalLine = TotalPush
+		}
+	}
+	return r, r.HasSpread || r.TotalLine != ""
+}
+
+// ATSRecord is a team's record against the spread and the total
+type ATSRecord struct {
+	Team        string // Abbreviation
+	Name        string
+	Wins        int // Covered
+	Losses      int
+	Pushes      int
+	Overs       int
+	Unders      int
+	TotalPushes int
+}
+
+// String formats the against-the-spread record as W-L or W-L-P
+func (r ATSRecord) String() string {
+	if r.Pushes > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Pushes)
+	}
+	return fmt.Sprintf("%d-%d", r.Wins, r.Losses)
+}
+
+// TotalString formats the over/under record as O-U or O-U-P
+func (r ATSRecord) TotalString() string {
+	if r.TotalPushes > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Overs, r.Unders, r.TotalPushes)
+	}
+	return fmt.Sprintf("%d-%d", r.Overs, r.Unders)
+}
+
+// CoverRate is the share of decided games covered, 0-1; pushes don't count
+func (r ATSRecord) CoverRate() float64 {
+	if r.Wins+r.Losses == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Wins+r.Losses)
+}
+
+// ATSReport sums up how a set of finished games went against their lines
+type ATSReport struct {
+	Games     int         // Finished games that had a line
+	Favorites ATSRecord   // Favorites against the spread, and every game's total
+	Teams     []ATSRecord // Best cover rate first
+}
@@ -0,0 +1,103 @@
+package models
+
+import "testing"
+
+// oddsGame is KC at BUF with the given line and final score
+func oddsGame(odds *Odds, away, home int) Game {
+	return Game{
+		Status:   StatusFinal,
+		AwayTeam: Team{Abbreviation: "KC", Score: away},
+		HomeTeam: Team{Abbreviation: "BUF", Score: home},
+		Odds:     odds,
+	}
+}
+
+func TestHomeLine(t *testing.T) {
+	tests := []struct {
+		name string
+		odds Odds
+		want float64
+	}{
+		{"home favored", Odds{Spread: -2.5}, -2.5},
+		{"away favored", Odds{Spread: 3}, 3},
+		{"home flag overrides positive spread", Odds{Spread: 2.5, HomeFavorite: true}, -2.5},
+		{"away flag overrides negative spread", Odds{Spread: -3, AwayFavorite: true}, 3},
+		{"flags agree with spread", Odds{Spread: -7, HomeFavorite: true}, -7},
+		{"pick'em", Odds{Spread: 0, Details: "EVEN"}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.odds.HomeLine(); got != tt.want {
+			t.Errorf("%s: HomeLine = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSpreadText(t *testing.T) {
+	tests := []struct {
+		name string
+		odds *Odds
+		want string
+	}{
+		{"no odds", nil, ""},
+		{"no spread", &Odds{OverUnder: 47.5}, ""},
+		{"home favored", &Odds{Spread: -2.5}, "BUF -2.5"},
+		{"away favored", &Odds{Spread: 3}, "KC -3"},
+		{"flag wins over sign", &Odds{Spread: 2.5, HomeFavorite: true}, "BUF -2.5"},
+		{"pick'em", &Odds{Spread: 0, Details: "EVEN"}, "EVEN"},
+	}
+	for _, tt := range tests {
+		if got := oddsGame(tt.odds, 0, 0).SpreadText(); got != tt.want {
+			t.Errorf("%s: SpreadText = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLineResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		odds      *Odds
+		away      int
+		home      int
+		want      LineResult
+		wantValid bool
+	}{
+		{"no odds", nil, 21, 30, LineResult{}, false},
+		{"no line", &Odds{Provider: "ESPN BET"}, 21, 30, LineResult{}, false},
+		{
+			"favorite covers", &Odds{Spread: -2.5, OverUnder: 45.5}, 21, 30,
+			LineResult{HasSpread: true, Covered: "BUF", Total: 51, TotalLine: TotalOver}, true,
+		},
+		{
+			"underdog covers in a loss", &Odds{Spread: -10, OverUnder: 55}, 21, 30,
+			LineResult{HasSpread: true, Covered: "KC", Total: 51, TotalLine: TotalUnder}, true,
+		},
+		{
+			"push on a whole number", &Odds{Spread: -9, OverUnder: 51}, 21, 30,
+			LineResult{HasSpread: true, Push: true, Total: 51, TotalLine: TotalPush}, true,
+		},
+		{
+			"flag overrides sign", &Odds{Spread: 3, HomeFavorite: true}, 20, 22,
+			LineResult{HasSpread: true, Covered: "KC", Total: 42}, true,
+		},
+		{
+			"pick'em winner covers", &Odds{Details: "EVEN"}, 24, 20,
+			LineResult{HasSpread: true, Covered: "KC", Total: 44}, true,
+		},
+		{
+			"total only", &Odds{OverUnder: 40.5}, 17, 20,
+			LineResult{Total: 37, TotalLine: TotalUnder}, true,
+		},
+	}
+	for _, tt := range tests {
+		got, ok := oddsGame(tt.odds, tt.away, tt.home).LineResult()
+		if ok != tt.wantValid || (ok && got != tt.want) {
+			t.Errorf("%s: LineResult = %+v, %v; want %+v, %v", tt.name, got, ok, tt.want, tt.wantValid)
+		}
+	}
+
+	live := oddsGame(&Odds{Spread: -2.5}, 21, 30)
+	live.Status = StatusInProgress
+	if _, ok := live.LineResult(); ok {
+		t.Error("settled a game in progress")
+	}
+}
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"strings"
+
+	"nfl-scores/playtext"
+)
+
 // Play represents a single play in a game
 type Play struct {
 	ID             string
@@ -13,14 +19,32 @@ type Play struct {
 	Down           string
 	Possession     string
 	YardsToEndzone int
+	WinProbability *WinProbability // After the play; nil when ESPN has none
+	StartDown      int             // Down before the snap; 0 on kickoffs and tries
+	EndPossession  string          // Team with the ball after the play; "" when ESPN doesn't say
+	Details        playtext.Play   // Players, yards, penalty and turnovers read from Text
+	DriveID        string
+}
+
+// WinProbability is ESPN's chance of each result after a play
+type WinProbability struct {
+	Home float64 // 0-1
+	Tie  float64
+}
+
+// Away is the away team's chance to win
+func (w WinProbability) Away() float64 {
+	return max(0, 1-w.Home-w.Tie)
 }
 
 // GameSummary contains detailed game info with plays
 type GameSummary struct {
 	Game           Game
 	CurrentPlay    *Play
-	RecentPlays    []Play
-	Situation      string // e.g., "1st & 10 at CAR 25"
+	RecentPlays    []Play        // Up to five plays of the current drive, newest first
+	Plays          []Play        // Every play so far, oldest first
+	Drives         []ReplayDrive // Every drive so far, indexing into Plays
+	Situation      string        // e.g., "1st & 10 at CAR 25"
 	YardsToEndzone int
 }
 
@@ -38,15 +62,72 @@ type ReplayPlay struct {
 	YardsToEndzone int
 	Down           string
 	DriveID        string
+	WinProbability *WinProbability // After the play; nil when ESPN has none
+	Details        playtext.Play   // Players, yards, penalty and turnovers read from Text
+	StartDown      int             // Down before the snap; 0 on kickoffs and tries
+	EndPossession  string          // Team with the ball after the play; "" when ESPN doesn't say
 }
 
-// ReplayDrive represents a drive in the replay
+// ReplayDrive represents a drive in a replay or live summary
 type ReplayDrive struct {
-	ID          string
-	Description string
-	Team        string
-	StartIndex  int // Index of first play in Plays slice
-	EndIndex    int // Index of last play in Plays slice
+	ID            string
+	Description   string
+	Team          string
+	StartIndex    int    // Index of first play in Plays slice
+	EndIndex      int    // Index of last play in Plays slice
+	Result        string // ESPN's result, e.g. "Touchdown", "Punt", "Interception"
+	ShortResult   string // e.g. "TD", "PUNT", "INT"
+	StartYardLine int    // Yards from the home team's goal line, 0-100
+	EndYardLine   int
+	StartText     string // e.g. "BUF 25"
+	EndText       string
+	Yards         int
+	IsScore       bool
+}
+
+// Contains reports whether the play at index is part of the drive
+func (d ReplayDrive) Contains(index int) bool {
+	return index >= d.StartIndex && index <= d.EndIndex
+}
+
+// ResultLabel is a short name for how the drive ended: TD, FG, Punt,
+// Turnover, Downs and so on
+func (d ReplayDrive) ResultLabel() string {
+	switch strings.ToUpper(d.ShortResult) {
+	case "TD":
+		return "TD"
+	case "FG":
+		return "FG"
+	case "PUNT":
+		return "Punt"
+	case "DOWNS":
+		return "Downs"
+	case "SF", "SAFETY":
+		return "Safety"
+	case "MISSED FG", "FG MISSED", "BLOCKED FG":
+		return "Missed FG"
+	case "END OF HALF":
+		return "Half"
+	case "END OF GAME":
+		return "End"
+	}
+	if d.Turnover() {
+		return "Turnover"
+	}
+	if d.Result != "" {
+		return d.Result
+	}
+	return "—"
+}
+
+// Turnover reports whether the drive ended by giving the ball away
+func (d ReplayDrive) Turnover() bool {
+	switch strings.ToUpper(d.ShortResult) {
+	case "INT", "FUMBLE", "FUMBLE RETURN TD", "INT TD", "TURNOVER":
+		return true
+	}
+	result := strings.ToLower(d.Result)
+	return strings.Contains(result, "interception") || strings.Contains(result, "fumble")
 }
 
 // GameReplay contains full game data for replay mode
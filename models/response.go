@@ -2,6 +2,7 @@ package models
 
 import (
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -17,6 +18,13 @@ type Event struct {
 	Date         string        `json:"date"`
 	Status       EventStatus   `json:"status"`
 	Competitions []Competition `json:"competitions"`
+	Weather      *WeatherInfo  `json:"weather,omitempty"`
+}
+
+// WeatherInfo is the forecast ESPN attaches to outdoor games
+type WeatherInfo struct {
+	DisplayValue string `json:"displayValue"`
+	Temperature  int    `json:"temperature"`
 }
 
 // EventStatus contains game status information
@@ -35,14 +43,58 @@ type StatusType struct {
 
 // Competition represents a single competition within an event
 type Competition struct {
-	Competitors []Competitor `json:"competitors"`
+	Competitors []Competitor    `json:"competitors"`
+	Venue       VenueInfo       `json:"venue"`
+	Broadcasts  []BroadcastInfo `json:"broadcasts"`
+	Odds        []OddsInfo      `json:"odds"`
+}
+
+// VenueInfo is where the game is played
+type VenueInfo struct {
+	FullName string `json:"fullName"`
+	Address  struct {
+		City  string `json:"city"`
+		State string `json:"state"`
+	} `json:"address"`
+	Indoor bool `json:"indoor"`
+}
+
+// BroadcastInfo lists the networks carrying the game in one market
+type BroadcastInfo struct {
+	Market string   `json:"market"`
+	Names  []string `json:"names"`
+}
+
+// OddsInfo is one sportsbook's betting line
+type OddsInfo struct {
+	Provider struct {
+		Name string `json:"name"`
+	} `json:"provider"`
+	Details      string       `json:"details"`
+	OverUnder    float64      `json:"overUnder"`
+	Spread       float64      `json:"spread"`
+	AwayTeamOdds TeamOddsInfo `json:"awayTeamOdds"`
+	HomeTeamOdds TeamOddsInfo `json:"homeTeamOdds"`
+}
+
+// TeamOddsInfo is one side of a betting line
+type TeamOddsInfo struct {
+	Favorite  bool `json:"favorite"`
+	MoneyLine int  `json:"moneyLine"`
 }
 
 // Competitor represents a team in the competition
 type Competitor struct {
-	HomeAway string   `json:"homeAway"`
-	Team     TeamInfo `json:"team"`
-	Score    string   `json:"score"`
+	HomeAway string       `json:"homeAway"`
+	Team     TeamInfo     `json:"team"`
+	Score    string       `json:"score"`
+	Records  []RecordInfo `json:"records"`
+}
+
+// RecordInfo is one of a team's season records (overall, home, road)
+type RecordInfo struct {
+	Type    string `json:"type"`
+	Summary string `json:"summary"`
 }
 
 // TeamInfo contains team details
@@ -63,21 +115,38 @@ func (r *ScoreboardResponse) ToGames() []Game {
 		game := Game{
 			ID:         event.ID,
 			StatusText: event.Status.Type.ShortDetail,
-			Status:     mapStatus(event.Status.Type.State),
+			Status:     StatusFromState(event.Status.Type.State),
 		}
 
 		// Parse start time
-		if t, err := time.Parse(time.RFC3339, event.Date); err == nil {
+		if t, ok := parseESPNTime(event.Date); ok {
 			game.StartTime = t
 		}
 
+		competition := event.Competitions[0]
+		game.Venue = Venue{
+			Name:   competition.Venue.FullName,
+			City:   competition.Venue.Address.City,
+			State:  competition.Venue.Address.State,
+			Indoor: competition.Venue.Indoor,
+		}
+		game.Broadcast = broadcastNames(competition.Broadcasts)
+		if event.Weather != nil {
+			game.Weather = &Weather{
+				Summary:     event.Weather.DisplayValue,
+				Temperature: event.Weather.Temperature,
+			}
+		}
+		game.Odds = toOdds(competition.Odds)
+
 		// Extract teams
-		for _, comp := range event.Competitions[0].Competitors {
+		for _, comp := range competition.Competitors {
 			score, _ := strconv.Atoi(comp.Score)
 			team := Team{
 				Name:         comp.Team.DisplayName,
 				Abbreviation: comp.Team.Abbreviation,
 				Score:        score,
+				Record:       overallRecord(comp.Records),
 			}
 
 			if comp.HomeAway == "home" {
@@ -93,8 +162,56 @@ func (r *ScoreboardResponse) ToGames() []Game {
 	return games
 }
 
-// mapStatus converts ESPN status state to GameStatus
-func mapStatus(state string) GameStatus {
+// broadcastNames joins the networks carrying a game, national ones first
+func broadcastNames(broadcasts []BroadcastInfo) string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, national := range []bool{true, false} {
+		for _, b := range broadcasts {
+			if (b.Market == "national") != national {
+				continue
+			}
+			for _, n := range b.Names {
+				if !seen[n] {
+					seen[n] = true
+					names = append(names, n)
+				}
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// toOdds converts the first (highest priority) book's line, or nil
+func toOdds(lines []OddsInfo) *Odds {
+	if len(lines) == 0 {
+		return nil
+	}
+	o := lines[0]
+	return &Odds{
+		Provider:      o.Provider.Name,
+		Details:       o.Details,
+		Spread:        o.Spread,
+		OverUnder:     o.OverUnder,
+		AwayMoneyLine: o.AwayTeamOdds.MoneyLine,
+		HomeMoneyLine: o.HomeTeamOdds.MoneyLine,
+		AwayFavorite:  o.AwayTeamOdds.Favorite,
+		HomeFavorite:  o.HomeTeamOdds.Favorite,
+	}
+}
+
+// overallRecord picks the season W-L(-T) summary from a team's records
+func overallRecord(records []RecordInfo) string {
+	for _, r := range records {
+		if r.Type == "total" {
+			return r.Summary
+		}
+	}
+	return ""
+}
+
+// StatusFromState converts an ESPN status state ("pre", "in", "post") to a GameStatus
+func StatusFromState(state string) GameStatus {
 	switch state {
 	case "in":
 		return StatusInProgress
@@ -104,3 +221,14 @@ func mapStatus(state string) GameStatus {
 		return StatusScheduled
 	}
 }
+
+// parseESPNTime parses ESPN timestamps, which usually omit seconds
+// ("2024-11-17T21:25Z")
+func parseESPNTime(s string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02T15:04Z07:00", time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
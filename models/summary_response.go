@@ -2,14 +2,45 @@ package models
 
 import (
 	"strconv"
-	"time"
+
+	"nfl-scores/playtext"
 )
 
 // SummaryResponse represents the ESPN game summary API response
 type SummaryResponse struct {
-	Header   SummaryHeader    `json:"header"`
-	Drives   Drives           `json:"drives"`
-	Boxscore BoxscoreResponse `json:"boxscore"`
+	Header         SummaryHeader        `json:"header"`
+	Drives         Drives               `json:"drives"`
+	Boxscore       BoxscoreResponse     `json:"boxscore"`
+	Pickcenter     []OddsInfo           `json:"pickcenter"` // Betting lines, same shape as scoreboard odds
+	WinProbability []WinProbabilityInfo `json:"winprobability"`
+}
+
+// WinProbabilityInfo is the win chance after one play
+type WinProbabilityInfo struct {
+	PlayID            string  `json:"playId"`
+	HomeWinPercentage float64 `json:"homeWinPercentage"` // 0-1, despite the name
+	TiePercentage     float64 `json:"tiePercentage"`
+	SecondsLeft       int     `json:"secondsLeft"`
+}
+
+// teamAbbreviations maps the game's team IDs to abbreviations
+func (r *SummaryResponse) teamAbbreviations() map[string]string {
+	byID := make(map[string]string, 2)
+	if len(r.Header.Competitions) > 0 {
+		for _, c := range r.Header.Competitions[0].Competitors {
+			byID[c.Team.ID] = c.Team.Abbreviation
+		}
+	}
+	return byID
+}
+
+// winProbabilities indexes the win probability timeline by play ID
+func (r *SummaryResponse) winProbabilities() map[string]*WinProbability {
+	byPlay := make(map[string]*WinProbability, len(r.WinProbability))
+	for _, w := range r.WinProbability {
+		byPlay[w.PlayID] = &WinProbability{Home: w.HomeWinPercentage, Tie: w.TiePercentage}
+	}
+	return byPlay
 }
 
 type SummaryHeader struct {
@@ -68,14 +99,21 @@ type DriveTeam struct {
 }
 
 type DriveStart struct {
-	Text string `json:"text"`
+	YardLine int    `json:"yardLine"` // Yards from the home team's goal line
+	Text     string `json:"text"`     // e.g. "BUF 25"
 }
 
 type DriveInfo struct {
-	ID          string     `json:"id"`
-	Description string     `json:"description"`
-	Team        DriveTeam  `json:"team"`
-	Plays       []PlayInfo `json:"plays"`
+	ID                 string     `json:"id"`
+	Description        string     `json:"description"`
+	Team               DriveTeam  `json:"team"`
+	Plays              []PlayInfo `json:"plays"`
+	Start              DriveStart `json:"start"`
+	End                DriveStart `json:"end"`
+	Yards              int        `json:"yards"`
+	IsScore            bool       `json:"isScore"`
+	Result             string     `json:"result"`             // e.g. "Touchdown", "Interception"
+	ShortDisplayResult string     `json:"shortDisplayResult"` // e.g. "TD", "INT"
 }
 
 type PlayInfo struct {
@@ -105,12 +143,17 @@ type PlayPeriod struct {
 }
 
 type PlayPosition struct {
-	Down             int    `json:"down"`
-	Distance         int    `json:"distance"`
-	YardLine         int    `json:"yardLine"`
-	YardsToEndzone   int    `json:"yardsToEndzone"`
-	DownDistanceText string `json:"downDistanceText"`
-	PossessionText   string `json:"possessionText"`
+	Down             int      `json:"down"`
+	Distance         int      `json:"distance"`
+	YardLine         int      `json:"yardLine"`
+	YardsToEndzone   int      `json:"yardsToEndzone"`
+	DownDistanceText string   `json:"downDistanceText"`
+	PossessionText   string   `json:"possessionText"`
+	Team             PlayTeam `json:"team"` // Team with the ball
+}
+
+type PlayTeam struct {
+	ID string `json:"id"`
 }
 
 // BoxscoreResponse represents the boxscore section of the ESPN API
@@ -168,11 +211,12 @@ func (r *SummaryResponse) ToGameSummary() *GameSummary {
 	game := Game{
 		ID:         r.Header.ID,
 		StatusText: comp.Status.Type.ShortDetail,
-		Status:     mapStatus(comp.Status.Type.State),
+		Status:     StatusFromState(comp.Status.Type.State),
+		Odds:       toOdds(r.Pickcenter),
 	}
 
 	// Parse start time
-	if t, err := time.Parse(time.RFC3339, comp.Date); err == nil {
+	if t, ok := parseESPNTime(comp.Date); ok {
 		game.StartTime = t
 	}
 
@@ -194,6 +238,61 @@ func (r *SummaryResponse) ToGameSummary() *GameSummary {
 	summary := &GameSummary{
 		Game:        game,
 		RecentPlays: make([]Play, 0),
+		Plays:       make([]Play, 0),
+	}
+
+	// Full play log; ESPN can list the current drive under previous as well
+	winProb := r.winProbabilities()
+	teams := r.teamAbbreviations()
+	seen := make(map[string]bool)
+	addDrive := func(plays []PlayInfo, drive ReplayDrive) {
+		team := drive.Team
+		drive.StartIndex = len(summary.Plays)
+		for _, p := range plays {
+			if seen[p.ID] {
+				continue
+			}
+			seen[p.ID] = true
+			summary.Plays = append(summary.Plays, Play{
+				ID:             p.ID,
+				Text:           p.Text,
+				Type:           p.Type.Text,
+				Clock:          p.Clock.DisplayValue,
+				Period:         p.Period.Number,
+				HomeScore:      p.HomeScore,
+				AwayScore:      p.AwayScore,
+				ScoringPlay:    p.ScoringPlay,
+				Down:           p.End.DownDistanceText,
+				Possession:     team,
+				YardsToEndzone: p.End.YardsToEndzone,
+				WinProbability: winProb[p.ID],
+				StartDown:      p.Start.Down,
+				EndPossession:  teams[p.End.Team.ID],
+				Details:        playtext.Parse(p.Text),
+				DriveID:        drive.ID,
+			})
+		}
+		drive.EndIndex = len(summary.Plays) - 1
+		switch n := len(summary.Drives); {
+		case drive.EndIndex < drive.StartIndex:
+			// Every play was already listed
+		case n > 0 && summary.Drives[n-1].ID == drive.ID:
+			summary.Drives[n-1].EndIndex = drive.EndIndex
+		default:
+			summary.Drives = append(summary.Drives, drive)
+		}
+	}
+	for _, d := range r.Drives.Previous {
+		addDrive(d.Plays, d.toReplayDrive())
+	}
+	if c := r.Drives.Current; c != nil {
+		addDrive(c.Plays, ReplayDrive{
+			ID:            c.ID,
+			Description:   c.Description,
+			Team:          c.Team.Abbreviation,
+			StartYardLine: c.Start.YardLine,
+			StartText:     c.Start.Text,
+		})
 	}
 
 	// Get current situation and plays
@@ -212,6 +311,10 @@ func (r *SummaryResponse) ToGameSummary() *GameSummary {
 			Down:           lastPlay.End.DownDistanceText,
 			Possession:     r.Drives.Current.Team.Abbreviation,
 			YardsToEndzone: lastPlay.End.YardsToEndzone,
+			WinProbability: winProb[lastPlay.ID],
+			StartDown:      lastPlay.Start.Down,
+			EndPossession:  teams[lastPlay.End.Team.ID],
+			Details:        playtext.Parse(lastPlay.Text),
 		}
 
 		summary.Situation = lastPlay.End.DownDistanceText
@@ -221,14 +324,15 @@ func (r *SummaryResponse) ToGameSummary() *GameSummary {
 		for i := len(r.Drives.Current.Plays) - 1; i >= 0 && len(summary.RecentPlays) < 5; i-- {
 			p := r.Drives.Current.Plays[i]
 			summary.RecentPlays = append(summary.RecentPlays, Play{
-				ID:          p.ID,
-				Text:        p.Text,
-				Type:        p.Type.Text,
-				Clock:       p.Clock.DisplayValue,
-				Period:      p.Period.Number,
-				HomeScore:   p.HomeScore,
-				AwayScore:   p.AwayScore,
-				ScoringPlay: p.ScoringPlay,
+				ID:             p.ID,
+				Text:           p.Text,
+				Type:           p.Type.Text,
+				Clock:          p.Clock.DisplayValue,
+				Period:         p.Period.Number,
+				HomeScore:      p.HomeScore,
+				AwayScore:      p.AwayScore,
+				ScoringPlay:    p.ScoringPlay,
+				WinProbability: winProb[p.ID],
 			})
 		}
 	}
@@ -236,6 +340,24 @@ func (r *SummaryResponse) ToGameSummary() *GameSummary {
 	return summary
 }
 
+// toReplayDrive copies a finished drive's details; the caller sets the
+// play indexes
+func (d DriveInfo) toReplayDrive() ReplayDrive {
+	return ReplayDrive{
+		ID:            d.ID,
+		Description:   d.Description,
+		Team:          d.Team.Abbreviation,
+		Result:        d.Result,
+		ShortResult:   d.ShortDisplayResult,
+		StartYardLine: d.Start.YardLine,
+		EndYardLine:   d.End.YardLine,
+		StartText:     d.Start.Text,
+		EndText:       d.End.Text,
+		Yards:         d.Yards,
+		IsScore:       d.IsScore,
+	}
+}
+
 // ToGameReplay converts the API response to a full game replay with all plays
 func (r *SummaryResponse) ToGameReplay() *GameReplay {
 	if len(r.Header.Competitions) == 0 {
@@ -247,7 +369,8 @@ func (r *SummaryResponse) ToGameReplay() *GameReplay {
 	game := Game{
 		ID:         r.Header.ID,
 		StatusText: comp.Status.Type.ShortDetail,
-		Status:     mapStatus(comp.Status.Type.State),
+		Status:     StatusFromState(comp.Status.Type.State),
+		Odds:       toOdds(r.Pickcenter),
 	}
 
 	// Extract teams
@@ -272,13 +395,11 @@ func (r *SummaryResponse) ToGameReplay() *GameReplay {
 	}
 
 	// Collect all plays from all drives in order
+	winProb := r.winProbabilities()
+	teams := r.teamAbbreviations()
 	for _, drive := range r.Drives.Previous {
-		rd := ReplayDrive{
-			ID:          drive.ID,
-			Description: drive.Description,
-			Team:        drive.Team.Abbreviation,
-			StartIndex:  len(replay.Plays),
-		}
+		rd := drive.toReplayDrive()
+		rd.StartIndex = len(replay.Plays)
 
 		for _, p := range drive.Plays {
 			play := ReplayPlay{
@@ -294,6 +415,10 @@ func (r *SummaryResponse) ToGameReplay() *GameReplay {
 				YardsToEndzone: p.End.YardsToEndzone,
 				Down:           p.End.DownDistanceText,
 				DriveID:        drive.ID,
+				WinProbability: winProb[p.ID],
+				Details:        playtext.Parse(p.Text),
+				StartDown:      p.Start.Down,
+				EndPossession:  teams[p.End.Team.ID],
 			}
 			replay.Plays = append(replay.Plays, play)
 		}
@@ -316,7 +441,8 @@ func (r *SummaryResponse) ToGameStats() *GameStats {
 	game := Game{
 		ID:         r.Header.ID,
 		StatusText: comp.Status.Type.ShortDetail,
-		Status:     mapStatus(comp.Status.Type.State),
+		Status:     StatusFromState(comp.Status.Type.State),
+		Odds:       toOdds(r.Pickcenter),
 	}
 
 	// Extract teams
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"fmt"
+
+	"nfl-scores/calendar"
+)
+
+// TeamGame is one game on a team's schedule
+type TeamGame struct {
+	Week calendar.Week
+	Game Game
+	Home bool // The team is the home side
+}
+
+// Team is the team's side of the game
+func (g TeamGame) Team() Team {
+	if g.Home {
+		return g.Game.HomeTeam
+	}
+	return g.Game.AwayTeam
+}
+
+// Opponent is the other side of the game
+func (g TeamGame) Opponent() Team {
+	if g.Home {
+		return g.Game.AwayTeam
+	}
+	return g.Game.HomeTeam
+}
+
+// Result is "W", "L" or "T" for a final game, otherwise ""
+func (g TeamGame) Result() string {
+	if g.Game.Status != StatusFinal {
+		return ""
+	}
+	us, them := g.Team().Score, g.Opponent().Score
+	switch {
+	case us > them:
+		return "W"
+	case us < them:
+		return "L"
+	}
+	return "T"
+}
+
+// TeamRecord summarizes a team's finished games
+type TeamRecord struct {
+	Wins, Losses, Ties             int
+	HomeWins, HomeLosses, HomeTies int
+	AwayWins, AwayLosses, AwayTies int
+	PointsFor, PointsAgainst       int
+	Streak                         string // e.g. "W3"; empty before the first final
+}
+
+// String formats the overall record as W-L or W-L-T
+func (r TeamRecord) String() string {
+	return formatRecord(r.Wins, r.Losses, r.Ties)
+}
+
+// Home formats the home record
+func (r TeamRecord) Home() string {
+	return formatRecord(r.HomeWins, r.HomeLosses, r.HomeTies)
+}
+
+// Away formats the away record
+func (r TeamRecord) Away() string {
+	return formatRecord(r.AwayWins, r.AwayLosses, r.AwayTies)
+}
+
+func formatRecord(w, l, t int) string {
+	if t > 0 {
+		return fmt.Sprintf("%d-%d-%d", w, l, t)
+	}
+	return fmt.Sprintf("%d-%d", w, l)
+}
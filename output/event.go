@@ -0,0 +1,59 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"nfl-scores/service"
+)
+
+// KindEvent marks an event line from --watch --events
+const KindEvent = "event"
+
+// Event is one line of the --events stream
+type Event struct {
+	SchemaVersion  int    `json:"schema_version"`
+	Kind           string `json:"kind"`
+	Type           string `json:"type"` // e.g. scoring_play, turnover, game_final
+	GameID         string `json:"game_id"`
+	DetectedAt     string `json:"detected_at"` // RFC 3339, UTC
+	Period         int    `json:"period"`
+	Clock          string `json:"clock"`
+	AwayScore      int    `json:"away_score"`
+	HomeScore      int    `json:"home_score"`
+	Possession     string `json:"possession,omitempty"`
+	PrevPossession string `json:"prev_possession,omitempty"`
+	PlayKind       string `json:"play_kind,omitempty"` // e.g. interception, punt, penalty
+	Play           *Play  `json:"play,omitempty"`
+}
+
+// NewEvent converts a detected game event
+func NewEvent(e service.Event) Event {
+	out := Event{
+		SchemaVersion:  SchemaVersion,
+		Kind:           KindEvent,
+		Type:           string(e.Type),
+		GameID:         e.GameID,
+		DetectedAt:     e.At.UTC().Format(time.RFC3339),
+		Period:         e.Period,
+		Clock:          e.Clock,
+		AwayScore:      e.AwayScore,
+		HomeScore:      e.HomeScore,
+		Possession:     e.Possession,
+		PrevPossession: e.PrevPossession,
+		PlayKind:       string(e.PlayKind),
+	}
+	if e.Play != nil {
+		p := newPlay(*e.Play)
+		out.Play = &p
+	}
+	return out
+}
+
+// WriteEvent writes e as a single NDJSON line
+func WriteEvent(w io.Writer, e service.Event) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(NewEvent(e))
+}
@@ -0,0 +1,191 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/models"
+	"nfl-scores/service"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// golden compares got with testdata/name, or rewrites it with -update
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test ./output -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s changed; if intended, run go test ./output -update and bump SchemaVersion for breaking changes\n--- got\n%s", name, got)
+	}
+}
+
+// fixtureService serves the bundled fixtures, with the Bills-Chiefs game
+// stopped partway through
+func fixtureService(t *testing.T) *service.ScoreService {
+	t.Helper()
+	srv, err := fakeespn.Start(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	return service.NewScoreService(client.NewESPNClientWithBaseURL(srv.URL()))
+}
+
+func TestGoldenDocuments(t *testing.T) {
+	svc := fixtureService(t)
+	ctx := context.Background()
+
+	games, err := svc.GetScoresByDates(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	summary, err := svc.GetGameSummary(ctx, fakeespn.DefaultLiveGameID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := map[string]Document{
+		"scoreboard":   NewScoreboard(games),
+		"live_summary": NewLiveSummary(summary),
+	}
+	for name, doc := range docs {
+		for _, format := range []Format{FormatJSON, FormatYAML, FormatCSV, FormatNDJSON} {
+			t.Run(name+"."+string(format), func(t *testing.T) {
+				var buf bytes.Buffer
+				if err := Write(&buf, format, doc); err != nil {
+					t.Fatal(err)
+				}
+				golden(t, name+"."+string(format), buf.Bytes())
+			})
+		}
+	}
+}
+
+func TestGoldenEvents(t *testing.T) {
+	at := time.Date(2024, 11, 17, 22, 41, 5, 0, time.FixedZone("EST", -5*60*60))
+	play := models.Play{
+		ID:          "401671001101",
+		Period:      2,
+		Clock:       "0:45",
+		Type:        "Pass Interception Return",
+		Text:        `(0:45) (Shotgun) P.Mahomes pass deep middle intended for T.Kelce INTERCEPTED by T.Bernard at BUF 12. "Replay: upheld"`,
+		Possession:  "KC",
+		Down:        "2nd & 10 at BUF 40",
+		AwayScore:   21,
+		HomeScore:   23,
+		ScoringPlay: false,
+	}
+	events := []service.Event{
+		{Type: service.EventNewPlay, GameID: "401671001", At: at, Play: &play, Period: 2, Clock: "0:45",
+			AwayScore: 21, HomeScore: 23, Possession: "KC", PlayKind: service.PlayInterception},
+		{Type: service.EventTurnover, GameID: "401671001", At: at, Play: &play, Period: 2, Clock: "0:45",
+			AwayScore: 21, HomeScore: 23, Possession: "BUF", PrevPossession: "KC", PlayKind: service.PlayInterception},
+		{Type: service.EventGameFinal, GameID: "401671001", At: at.Add(time.Hour), Period: 4, Clock: "0:00",
+			AwayScore: 21, HomeScore: 30},
+	}
+
+	var buf bytes.Buffer
+	for _, e := range events {
+		if err := WriteEvent(&buf, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	golden(t, "events.ndjson", buf.Bytes())
+}
+
+func TestYAMLQuoting(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"", `""`},
+		{"yes", `"yes"`},
+		{"no", `"no"`},
+		{"null", `"null"`},
+		{"~", `"~"`},
+		{"true", `"true"`},
+		{"3:45", `"3:45"`},
+		{"1st & 10", `"1st & 10"`},
+		{"key: value", `"key: value"`},
+		{"# not a comment", `"# not a comment"`},
+		{"  leading spaces", `"  leading spaces"`},
+		{"trailing ", `"trailing "`},
+		{"- dash", `"- dash"`},
+		{"007", `"007"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{"line\nbreak", `"line\nbreak"`},
+		{"tab\there", `"tab\there"`},
+		{`back\slash`, `"back\\slash"`},
+		{"Ja'Marr", `"Ja'Marr"`},
+		{"café", `"café"`},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := encodeYAML(&buf, struct {
+			V string `json:"v"`
+		}{tt.value}); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.TrimSuffix(buf.String(), "\n"); got != "v: "+tt.want {
+			t.Errorf("%q encoded as %s, want v: %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestYAMLKeysAndScalars(t *testing.T) {
+	doc := struct {
+		Stats  map[string]string `json:"stats"`
+		Spread float64           `json:"spread"`
+		Big    float64           `json:"big"`
+		NaN    float64           `json:"nan"`
+		Empty  []string          `json:"empty"`
+		None   *Play             `json:"none"`
+		Skip   string            `json:"skip,omitempty"`
+	}{
+		Stats:  map[string]string{"YDS": "245", "C/ATT": "20/31", "no": "1", "Null": "", "1st": "x"},
+		Spread: -2.5,
+		Big:    1e21,
+		NaN:    math.NaN(),
+	}
+	var buf bytes.Buffer
+	if err := encodeYAML(&buf, doc); err != nil {
+		t.Fatal(err)
+	}
+	want := `stats:
+  "1st": "x"
+  "C/ATT": "20/31"
+  "Null": ""
+  YDS: "245"
+  "no": "1"
+spread: -2.5
+big: 1000000000000000000000
+nan: .nan
+empty: []
+none: null
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,309 @@
+// Package output renders scoreboards, live summaries, replays and box scores
+// in machine-readable formats. The shapes here are a public contract for
+// scripts: see docs/output-schema.md, and bump SchemaVersion whenever a
+// field is renamed, removed or changes meaning. Adding fields does not
+// require a bump.
+package output
+
+import (
+	"time"
+
+	"nfl-scores/models"
+)
+
+// SchemaVersion identifies the layout of every document and row
+const SchemaVersion = 1
+
+// Document kinds
+const (
+	KindScoreboard  = "scoreboard"
+	KindLiveSummary = "live_summary"
+	KindReplay      = "replay"
+	KindBoxScore    = "box_score"
+)
+
+// Team is one side of a game
+type Team struct {
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+	Score        int    `json:"score"`
+}
+
+// Game is a single game as shown on the scoreboard
+type Game struct {
+	ID         string `json:"id"`
+	Status     string `json:"status"` // scheduled, in_progress or final
+	StatusText string `json:"status_text"`
+	StartTime  string `json:"start_time,omitempty"` // RFC 3339, UTC
+	Away       Team   `json:"away"`
+	Home       Team   `json:"home"`
+	Odds       *Odds  `json:"odds,omitempty"` // Omitted when ESPN has no line
+}
+
+// Odds is a game's betting line and, once final, how it settled
+type Odds struct {
+	Provider      string  `json:"provider"`
+	Details       string  `json:"details"`     // e.g. "BUF -2.5"
+	Favorite      string  `json:"favorite"`    // Abbreviation; "" for a pick'em
+	HomeSpread    float64 `json:"home_spread"` // Negative when the home team is favored
+	OverUnder     float64 `json:"over_under"`
+	AwayMoneyLine int     `json:"away_money_line"`
+	HomeMoneyLine int     `json:"home_money_line"`
+	Covered       string  `json:"covered,omitempty"`      // Final only: abbreviation, or "push"
+	TotalResult   string  `json:"total_result,omitempty"` // Final only: over, under or push
+}
+
+// Play is one play from a live summary or replay
+type Play struct {
+	ID             string `json:"id"`
+	Period         int    `json:"period"`
+	Clock          string `json:"clock"`
+	Type           string `json:"type"`
+	Text           string `json:"text"`
+	Possession     string `json:"possession"`
+	Down           string `json:"down"`
+	YardsToEndzone int    `json:"yards_to_endzone"`
+	AwayScore      int    `json:"away_score"`
+	HomeScore      int    `json:"home_score"`
+	ScoringPlay    bool   `json:"scoring_play"`
+	DriveID        string `json:"drive_id,omitempty"`
+	// Home team's chance to win after the play, 0-1; omitted when ESPN has none
+	HomeWinProbability *float64 `json:"home_win_probability,omitempty"`
+}
+
+// Drive groups consecutive replay plays
+type Drive struct {
+	ID          string `json:"id"`
+	Team        string `json:"team"`
+	Description string `json:"description"`
+	FirstPlay   int    `json:"first_play"` // Index into Replay.Plays
+	LastPlay    int    `json:"last_play"`
+}
+
+// PlayerStats is one player's line in a box score category
+type PlayerStats struct {
+	Category string            `json:"category"` // passing, rushing, receiving, ...
+	Name     string            `json:"name"`
+	Position string            `json:"position,omitempty"`
+	Stats    map[string]string `json:"stats"` // Column label -> value, e.g. "YDS" -> "245"
+}
+
+// TeamStats is one team's half of a box score
+type TeamStats struct {
+	Name         string            `json:"name"`
+	Abbreviation string            `json:"abbreviation"`
+	Totals       map[string]string `json:"totals"` // e.g. "totalYards" -> "350"
+	Players      []PlayerStats     `json:"players"`
+}
+
+// Scoreboard lists the games in a date range
+type Scoreboard struct {
+	SchemaVersion int    `json:"schema_version"`
+	Kind          string `json:"kind"`
+	Games         []Game `json:"games"`
+}
+
+// LiveSummary is a snapshot of one game in progress
+type LiveSummary struct {
+	SchemaVersion  int    `json:"schema_version"`
+	Kind           string `json:"kind"`
+	Game           Game   `json:"game"`
+	Situation      string `json:"situation"`
+	YardsToEndzone int    `json:"yards_to_endzone"`
+	CurrentPlay    *Play  `json:"current_play"`
+	RecentPlays    []Play `json:"recent_plays"` // Newest first
+}
+
+// Replay is every play of a game in order
+type Replay struct {
+	SchemaVersion int     `json:"schema_version"`
+	Kind          string  `json:"kind"`
+	Game          Game    `json:"game"`
+	Plays         []Play  `json:"plays"`
+	Drives        []Drive `json:"drives"`
+}
+
+// BoxScore is the team and player statistics for a game
+type BoxScore struct {
+	SchemaVersion int       `json:"schema_version"`
+	Kind          string    `json:"kind"`
+	Game          Game      `json:"game"`
+	Away          TeamStats `json:"away"`
+	Home          TeamStats `json:"home"`
+}
+
+// NewScoreboard converts scoreboard games
+func NewScoreboard(games []models.Game) Scoreboard {
+	doc := Scoreboard{SchemaVersion: SchemaVersion, Kind: KindScoreboard, Games: make([]Game, 0, len(games))}
+	for _, g := range games {
+		doc.Games = append(doc.Games, newGame(g))
+	}
+	return doc
+}
+
+// NewLiveSummary converts a live game summary
+func NewLiveSummary(s *models.GameSummary) LiveSummary {
+	doc := LiveSummary{
+		SchemaVersion:  SchemaVersion,
+		Kind:           KindLiveSummary,
+		Game:           newGame(s.Game),
+		Situation:      s.Situation,
+		YardsToEndzone: s.YardsToEndzone,
+		RecentPlays:    make([]Play, 0, len(s.RecentPlays)),
+	}
+	if s.CurrentPlay != nil {
+		p := newPlay(*s.CurrentPlay)
+		doc.CurrentPlay = &p
+	}
+	for _, p := range s.RecentPlays {
+		doc.RecentPlays = append(doc.RecentPlays, newPlay(p))
+	}
+	return doc
+}
+
+// NewReplay converts a full game replay
+func NewReplay(r *models.GameReplay) Replay {
+	doc := Replay{
+		SchemaVersion: SchemaVersion,
+		Kind:          KindReplay,
+		Game:          newGame(r.Game),
+		Plays:         make([]Play, 0, len(r.Plays)),
+		Drives:        make([]Drive, 0, len(r.Drives)),
+	}
+	for _, p := range r.Plays {
+		doc.Plays = append(doc.Plays, Play{
+			ID:                 p.ID,
+			Period:             p.Period,
+			Clock:              p.Clock,
+			Type:               p.Type,
+			Text:               p.Text,
+			Possession:         p.Possession,
+			Down:               p.Down,
+			YardsToEndzone:     p.YardsToEndzone,
+			AwayScore:          p.AwayScore,
+			HomeScore:          p.HomeScore,
+			ScoringPlay:        p.ScoringPlay,
+			DriveID:            p.DriveID,
+			HomeWinProbability: homeWinProbability(p.WinProbability),
+		})
+	}
+	for _, d := range r.Drives {
+		doc.Drives = append(doc.Drives, Drive{
+			ID:          d.ID,
+			Team:        d.Team,
+			Description: d.Description,
+			FirstPlay:   d.StartIndex,
+			LastPlay:    d.EndIndex,
+		})
+	}
+	return doc
+}
+
+// NewBoxScore converts game statistics
+func NewBoxScore(s *models.GameStats) BoxScore {
+	return BoxScore{
+		SchemaVersion: SchemaVersion,
+		Kind:          KindBoxScore,
+		Game:          newGame(s.Game),
+		Away:          newTeamStats(s.AwayStats),
+		Home:          newTeamStats(s.HomeStats),
+	}
+}
+
+func newGame(g models.Game) Game {
+	out := Game{
+		ID:         g.ID,
+		Status:     statusName(g.Status),
+		StatusText: g.StatusText,
+		Away:       Team{Name: g.AwayTeam.Name, Abbreviation: g.AwayTeam.Abbreviation, Score: g.AwayTeam.Score},
+		Home:       Team{Name: g.HomeTeam.Name, Abbreviation: g.HomeTeam.Abbreviation, Score: g.HomeTeam.Score},
+	}
+	if !g.StartTime.IsZero() {
+		out.StartTime = g.StartTime.UTC().Format(time.RFC3339)
+	}
+	if g.Odds != nil {
+		out.Odds = &Odds{
+			Provider:      g.Odds.Provider,
+			Details:       g.Odds.Details,
+			Favorite:      g.Favorite(),
+			HomeSpread:    g.Odds.HomeLine(),
+			OverUnder:     g.Odds.OverUnder,
+			AwayMoneyLine: g.Odds.AwayMoneyLine,
+			HomeMoneyLine: g.Odds.HomeMoneyLine,
+		}
+		if res, ok := g.LineResult(); ok {
+			out.Odds.Covered = res.Covered
+			if res.Push {
+				out.Odds.Covered = "push"
+			}
+			out.Odds.TotalResult = res.TotalLine
+		}
+	}
+	return out
+}
+
+func newPlay(p models.Play) Play {
+	return Play{
+		ID:                 p.ID,
+		Period:             p.Period,
+		Clock:              p.Clock,
+		Type:               p.Type,
+		Text:               p.Text,
+		Possession:         p.Possession,
+		Down:               p.Down,
+		YardsToEndzone:     p.YardsToEndzone,
+		AwayScore:          p.AwayScore,
+		HomeScore:          p.HomeScore,
+		ScoringPlay:        p.ScoringPlay,
+		HomeWinProbability: homeWinProbability(p.WinProbability),
+	}
+}
+
+// homeWinProbability unwraps a play's win probability for the schema
+func homeWinProbability(wp *models.WinProbability) *float64 {
+	if wp == nil {
+		return nil
+	}
+	return &wp.Home
+}
+
+func newTeamStats(t models.TeamStats) TeamStats {
+	out := TeamStats{
+		Name:         t.TeamName,
+		Abbreviation: t.TeamAbbr,
+		Totals:       t.Totals,
+		Players:      make([]PlayerStats, 0),
+	}
+	if out.Totals == nil {
+		out.Totals = map[string]string{}
+	}
+	for _, cat := range t.PlayerStats {
+		for _, p := range cat.Players {
+			stats := make(map[string]string, len(cat.Labels))
+			for i, label := range cat.Labels {
+				if i < len(p.Stats) {
+					stats[label] = p.Stats[i]
+				}
+			}
+			out.Players = append(out.Players, PlayerStats{
+				Category: cat.Category,
+				Name:     p.Name,
+				Position: p.Position,
+				Stats:    stats,
+			})
+		}
+	}
+	return out
+}
+
+// statusName is the schema spelling of a game status
+func statusName(s models.GameStatus) string {
+	switch s {
+	case models.StatusInProgress:
+		return "in_progress"
+	case models.StatusFinal:
+		return "final"
+	default:
+		return "scheduled"
+	}
+}
@@ -0,0 +1,42 @@
+package output
+
+import (
+	"time"
+
+	"nfl-scores/models"
+)
+
+// KindNotification marks a webhook payload
+const KindNotification = "notification"
+
+// Notification is the body POSTed to a webhook
+type Notification struct {
+	SchemaVersion int    `json:"schema_version"`
+	Kind          string `json:"kind"`
+	Event         string `json:"event"` // touchdown, field_goal, turnover, final or ping
+	DeliveryID    string `json:"delivery_id"`
+	SentAt        string `json:"sent_at"` // RFC 3339, UTC
+	Game          *Game  `json:"game,omitempty"`
+	Play          *Play  `json:"play,omitempty"`
+}
+
+// NewNotification builds a webhook payload. game and play may be nil
+// (a ping has neither; a final score has no play).
+func NewNotification(event, deliveryID string, at time.Time, game *models.Game, play *models.Play) Notification {
+	out := Notification{
+		SchemaVersion: SchemaVersion,
+		Kind:          KindNotification,
+		Event:         event,
+		DeliveryID:    deliveryID,
+		SentAt:        at.UTC().Format(time.RFC3339),
+	}
+	if game != nil {
+		g := newGame(*game)
+		out.Game = &g
+	}
+	if play != nil {
+		p := newPlay(*play)
+		out.Play = &p
+	}
+	return out
+}
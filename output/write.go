@@ -0,0 +1,285 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Format selects how documents are written
+type Format string
+
+// Supported formats; FormatText is the human TerminalFormatter output
+const (
+	FormatText   Format = "text"
+	FormatJSON   Format = "json"
+	FormatYAML   Format = "yaml"
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// ParseFormat validates an --output value
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "", FormatText:
+		return FormatText, nil
+	case FormatJSON, FormatYAML, FormatCSV, FormatNDJSON:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown output format %q (want text, json, yaml, csv or ndjson)", s)
+}
+
+// Document is one of Scoreboard, LiveSummary, Replay or BoxScore
+type Document interface {
+	table() table
+}
+
+// Write encodes doc to w. JSON and YAML write the nested document; CSV and
+// NDJSON write its flat rows, one per game, play or stat.
+func Write(w io.Writer, format Format, doc Document) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(doc)
+	case FormatYAML:
+		return encodeYAML(w, doc)
+	case FormatCSV:
+		return doc.table().writeCSV(w)
+	case FormatNDJSON:
+		return doc.table().writeNDJSON(w)
+	}
+	return fmt.Errorf("format %q has no machine-readable encoding", format)
+}
+
+// GameRow is the flat form of a scoreboard game
+type GameRow struct {
+	SchemaVersion    int    `json:"schema_version"`
+	Kind             string `json:"kind"` // "game"
+	ID               string `json:"id"`
+	Status           string `json:"status"`
+	StatusText       string `json:"status_text"`
+	StartTime        string `json:"start_time"`
+	AwayName         string `json:"away_name"`
+	AwayAbbreviation string `json:"away_abbreviation"`
+	AwayScore        int    `json:"away_score"`
+	HomeName         string `json:"home_name"`
+	HomeAbbreviation string `json:"home_abbreviation"`
+	HomeScore        int    `json:"home_score"`
+}
+
+// SummaryRow is the flat form of a live summary: the game and its latest play
+type SummaryRow struct {
+	SchemaVersion    int    `json:"schema_version"`
+	Kind             string `json:"kind"` // "live_summary"
+	GameID           string `json:"game_id"`
+	Status           string `json:"status"`
+	StatusText       string `json:"status_text"`
+	AwayAbbreviation string `json:"away_abbreviation"`
+	AwayScore        int    `json:"away_score"`
+	HomeAbbreviation string `json:"home_abbreviation"`
+	HomeScore        int    `json:"home_score"`
+	Situation        string `json:"situation"`
+	YardsToEndzone   int    `json:"yards_to_endzone"`
+	Period           int    `json:"period"`
+	Clock            string `json:"clock"`
+	Possession       string `json:"possession"`
+	LastPlay         string `json:"last_play"`
+}
+
+// PlayRow is the flat form of a replay play
+type PlayRow struct {
+	SchemaVersion  int    `json:"schema_version"`
+	Kind           string `json:"kind"` // "play"
+	GameID         string `json:"game_id"`
+	Sequence       int    `json:"sequence"` // 0-based position in the game
+	ID             string `json:"id"`
+	Period         int    `json:"period"`
+	Clock          string `json:"clock"`
+	Type           string `json:"type"`
+	Text           string `json:"text"`
+	Possession     string `json:"possession"`
+	Down           string `json:"down"`
+	YardsToEndzone int    `json:"yards_to_endzone"`
+	AwayScore      int    `json:"away_score"`
+	HomeScore      int    `json:"home_score"`
+	ScoringPlay    bool   `json:"scoring_play"`
+	DriveID        string `json:"drive_id"`
+}
+
+// StatRow is one value from a box score, in long form
+type StatRow struct {
+	SchemaVersion int    `json:"schema_version"`
+	Kind          string `json:"kind"` // "stat"
+	GameID        string `json:"game_id"`
+	Team          string `json:"team"`     // Abbreviation
+	Category      string `json:"category"` // "team" for team totals
+	Player        string `json:"player"`   // Empty for team totals
+	Position      string `json:"position"`
+	Stat          string `json:"stat"`
+	Value         string `json:"value"`
+}
+
+func (d Scoreboard) table() table {
+	rows := make([]GameRow, 0, len(d.Games))
+	for _, g := range d.Games {
+		rows = append(rows, GameRow{
+			SchemaVersion:    SchemaVersion,
+			Kind:             "game",
+			ID:               g.ID,
+			Status:           g.Status,
+			StatusText:       g.StatusText,
+			StartTime:        g.StartTime,
+			AwayName:         g.Away.Name,
+			AwayAbbreviation: g.Away.Abbreviation,
+			AwayScore:        g.Away.Score,
+			HomeName:         g.Home.Name,
+			HomeAbbreviation: g.Home.Abbreviation,
+			HomeScore:        g.Home.Score,
+		})
+	}
+	return newTable(rows)
+}
+
+func (d LiveSummary) table() table {
+	row := SummaryRow{
+		SchemaVersion:    SchemaVersion,
+		Kind:             KindLiveSummary,
+		GameID:           d.Game.ID,
+		Status:           d.Game.Status,
+		StatusText:       d.Game.StatusText,
+		AwayAbbreviation: d.Game.Away.Abbreviation,
+		AwayScore:        d.Game.Away.Score,
+		HomeAbbreviation: d.Game.Home.Abbreviation,
+		HomeScore:        d.Game.Home.Score,
+		Situation:        d.Situation,
+		YardsToEndzone:   d.YardsToEndzone,
+	}
+	if p := d.CurrentPlay; p != nil {
+		row.Period = p.Period
+		row.Clock = p.Clock
+		row.Possession = p.Possession
+		row.LastPlay = p.Text
+	}
+	return newTable([]SummaryRow{row})
+}
+
+func (d Replay) table() table {
+	rows := make([]PlayRow, 0, len(d.Plays))
+	for i, p := range d.Plays {
+		rows = append(rows, PlayRow{
+			SchemaVersion:  SchemaVersion,
+			Kind:           "play",
+			GameID:         d.Game.ID,
+			Sequence:       i,
+			ID:             p.ID,
+			Period:         p.Period,
+			Clock:          p.Clock,
+			Type:           p.Type,
+			Text:           p.Text,
+			Possession:     p.Possession,
+			Down:           p.Down,
+			YardsToEndzone: p.YardsToEndzone,
+			AwayScore:      p.AwayScore,
+			HomeScore:      p.HomeScore,
+			ScoringPlay:    p.ScoringPlay,
+			DriveID:        p.DriveID,
+		})
+	}
+	return newTable(rows)
+}
+
+func (d BoxScore) table() table {
+	var rows []StatRow
+	for _, t := range []TeamStats{d.Away, d.Home} {
+		stat := func(category, player, position, name, value string) {
+			rows = append(rows, StatRow{
+				SchemaVersion: SchemaVersion,
+				Kind:          "stat",
+				GameID:        d.Game.ID,
+				Team:          t.Abbreviation,
+				Category:      category,
+				Player:        player,
+				Position:      position,
+				Stat:          name,
+				Value:         value,
+			})
+		}
+		for _, key := range sortedKeys(t.Totals) {
+			stat("team", "", "", key, t.Totals[key])
+		}
+		for _, p := range t.Players {
+			for _, key := range sortedKeys(p.Stats) {
+				stat(p.Category, p.Name, p.Position, key, p.Stats[key])
+			}
+		}
+	}
+	return newTable(rows)
+}
+
+// table is a document flattened into rows of one struct type
+type table struct {
+	header  []string
+	records [][]string
+	rows    []any
+}
+
+// newTable flattens rows using their json tags as column names
+func newTable[T any](rows []T) table {
+	typ := reflect.TypeFor[T]()
+	t := table{}
+	for i := range typ.NumField() {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		t.header = append(t.header, name)
+	}
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		record := make([]string, v.NumField())
+		for i := range record {
+			record[i] = formatScalar(v.Field(i))
+		}
+		t.records = append(t.records, record)
+		t.rows = append(t.rows, row)
+	}
+	return t
+}
+
+func (t table) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(t.records); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func (t table) writeNDJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, row := range t.rows {
+		if err := enc.Encode(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatScalar renders a string, int or bool field for CSV
+func formatScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,168 @@
+package output
+
+import (
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// plainKey matches mapping keys that need no quoting
+var plainKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reservedWords read as booleans or null in YAML 1.1 or 1.2, so keys spelled
+// like them are quoted
+var reservedWords = map[string]bool{
+	"null": true, "true": true, "false": true, "yes": true, "no": true,
+	"on": true, "off": true, "y": true, "n": true,
+}
+
+// encodeYAML writes v as block-style YAML. Field names come from the json
+// tags so both encodings share one schema. Strings are always
+// double-quoted, which keeps values like "no", "3:45" or "1st & 10"
+// unambiguous without a YAML library.
+func encodeYAML(w io.Writer, v any) error {
+	var sb strings.Builder
+	writeYAMLBlock(&sb, reflect.ValueOf(v), 0)
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+type yamlField struct {
+	key string
+	val reflect.Value
+}
+
+// writeYAMLBlock writes a non-empty mapping or sequence starting at indent
+func writeYAMLBlock(sb *strings.Builder, v reflect.Value, indent int) {
+	v = reflect.Indirect(v)
+	pad := strings.Repeat(" ", indent)
+
+	if v.Kind() == reflect.Slice {
+		for i := range v.Len() {
+			item := reflect.Indirect(v.Index(i))
+			if isYAMLBlock(item) && !isYAMLEmpty(item) {
+				// First line of the nested block shares the "- " line
+				var sub strings.Builder
+				writeYAMLBlock(&sub, item, indent+2)
+				sb.WriteString(pad + "- " + sub.String()[indent+2:])
+				continue
+			}
+			sb.WriteString(pad + "-")
+			writeYAMLValue(sb, item, indent+2)
+		}
+		return
+	}
+
+	for _, f := range yamlFields(v) {
+		sb.WriteString(pad + yamlKey(f.key) + ":")
+		writeYAMLValue(sb, f.val, indent+2)
+	}
+}
+
+// writeYAMLValue finishes a "key:" or "-" line with a scalar, or starts a
+// nested block on the following lines
+func writeYAMLValue(sb *strings.Builder, v reflect.Value, indent int) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			sb.WriteString(" null\n")
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case isYAMLBlock(v) && isYAMLEmpty(v):
+		if v.Kind() == reflect.Slice {
+			sb.WriteString(" []\n")
+		} else {
+			sb.WriteString(" {}\n")
+		}
+	case isYAMLBlock(v):
+		sb.WriteString("\n")
+		writeYAMLBlock(sb, v, indent)
+	default:
+		sb.WriteString(" " + yamlScalar(v) + "\n")
+	}
+}
+
+// yamlFields lists struct fields by json tag, or map entries by sorted key
+func yamlFields(v reflect.Value) []yamlField {
+	var fields []yamlField
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			name, opts, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			if opts == "omitempty" && v.Field(i).IsZero() {
+				continue
+			}
+			fields = append(fields, yamlField{name, v.Field(i)})
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			fields = append(fields, yamlField{k.String(), v.MapIndex(k)})
+		}
+	}
+	return fields
+}
+
+func isYAMLBlock(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice:
+		return true
+	}
+	return false
+}
+
+func isYAMLEmpty(v reflect.Value) bool {
+	if v.Kind() == reflect.Struct {
+		return len(yamlFields(v)) == 0
+	}
+	return v.Len() == 0
+}
+
+func yamlKey(k string) string {
+	if plainKey.MatchString(k) && !reservedWords[strings.ToLower(k)] {
+		return k
+	}
+	return strconv.Quote(k)
+}
+
+func yamlScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Float32, reflect.Float64:
+		switch f := v.Float(); {
+		case math.IsNaN(f):
+			return ".nan"
+		case math.IsInf(f, 1):
+			return ".inf"
+		case math.IsInf(f, -1):
+			return "-.inf"
+		default:
+			// Never exponent form, which YAML 1.1 parsers read as a string
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	return formatScalar(v)
+}
+
+// sortedKeys returns m's keys in a stable order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,299 @@
+// Package playtext reads ESPN's play-by-play prose, such as
+// "(14:20) (Shotgun) J.Allen pass short right to K.Shakir to BUF 44 for 13
+// yards (T.McDuffie).", into typed fields: who threw, ran, caught and
+// tackled, how many yards, and any penalty, sack, turnover or kick.
+//
+// Parsing is best effort. Fields the text doesn't mention are left zero,
+// and unfamiliar wording yields a Play with only what could be recognized.
+package playtext
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kind is the main action of a play
+type Kind string
+
+const (
+	KindPass       Kind = "pass"
+	KindRush       Kind = "rush"
+	KindSack       Kind = "sack"
+	KindPunt       Kind = "punt"
+	KindKickoff    Kind = "kickoff"
+	KindFieldGoal  Kind = "field_goal"
+	KindExtraPoint Kind = "extra_point"
+	KindTwoPoint   Kind = "two_point"
+	KindKneel      Kind = "kneel"
+	KindSpike      Kind = "spike"
+	KindPenalty    Kind = "penalty" // Nothing but a penalty, e.g. a false start
+	KindTimeout    Kind = "timeout"
+	KindOther      Kind = "other" // End of quarter, two-minute warning, anything unrecognized
+)
+
+// Play is what a play's text says happened
+type Play struct {
+	Kind       Kind
+	Passer     string
+	Rusher     string // Ball carrier on a run, scramble or kneel
+	Receiver   string // Target of a pass, caught or not
+	Tacklers   []string
+	Yards      int  // Gained by the offense; negative for a loss
+	Incomplete bool // Pass fell incomplete
+	Sack       bool
+	Touchdown  bool
+	Safety     bool
+
+	Interception bool
+	Interceptor  string
+
+	Fumble       bool
+	ForcedBy     string
+	RecoveredBy  string
+	RecoveryTeam string // Abbreviation of the team that recovered
+
+	Kicker       string // Punter or kicker
+	KickDistance int    // Yards the kick traveled, or the field goal distance
+	KickGood     bool   // Field goal or extra point is good
+	Blocked      bool
+	Touchback    bool
+	FairCatch    bool
+	Returner     string
+	ReturnYards  int
+
+	Penalty *Penalty // Nil when no flag was thrown
+}
+
+// Penalty is a flag on the play
+type Penalty struct {
+	Team       string // Abbreviation
+	Player     string // Empty for team penalties like delay of game
+	Type       string // e.g. "Offensive Holding"
+	Yards      int
+	NoPlay     bool // The play was wiped out
+	Declined   bool
+	Offsetting bool
+}
+
+// name matches ESPN's abbreviated player names: "J.Allen", "T.McDuffie",
+// "Ja.Chase", "A.St. Brown"
+const name = `[A-Z][A-Za-z']*\.[A-Z][A-Za-z'-]*(?:\.? [A-Z][a-z][A-Za-z'-]*)?`
+
+var (
+	prefixRe = regexp.MustCompile(`^\s*(?:\([^)]*\)\s*)+`)
+	// A sentence ends at ". " before a capital, but not inside a name like "A.St. Brown"
+	sentenceRe = regexp.MustCompile(`[.!]\s+(?:[A-Z][A-Z]|[A-Z][A-Za-z']*\.[A-Z])`)
+
+	passRe       = regexp.MustCompile(`^(` + name + `) pass(?: (incomplete))?(?: (?:short|deep))?(?: (?:left|middle|right))?(?: (?:to|intended for) (` + name + `))?`)
+	sackRe       = regexp.MustCompile(`^(` + name + `) sacked`)
+	rushRe       = regexp.MustCompile(`^(` + name + `) (?:(?:left|right) (?:end|tackle|guard)|up the middle|scrambles|rushes|runs|to |for |pushed|ran )`)
+	kneelRe      = regexp.MustCompile(`^(` + name + `) kneels`)
+	spikeRe      = regexp.MustCompile(`^(` + name + `) spiked`)
+	puntRe       = regexp.MustCompile(`^(` + name + `) punts (\d+) yards?`)
+	puntBlockRe  = regexp.MustCompile(`^(` + name + `) punt is BLOCKED`)
+	kickoffRe    = regexp.MustCompile(`^(` + name + `) kicks(?: onside)? (\d+) yards?`)
+	fieldGoalRe  = regexp.MustCompile(`^(` + name + `) (\d+) yard field goal is (GOOD|No Good|BLOCKED)`)
+	extraPointRe = regexp.MustCompile(`^(` + name + `) extra point is (GOOD|No Good|BLOCKED)`)
+	twoPointRe   = regexp.MustCompile(`(?i)two-point conversion attempt`)
+	timeoutRe    = regexp.MustCompile(`^Timeout\b`)
+
+	yardsRe      = regexp.MustCompile(`for (-?\d+) yards?|for (no gain)`)
+	tacklersRe   = regexp.MustCompile(`\((` + name + `(?:(?:; | and |, )` + name + `)*)\)`)
+	nameRe       = regexp.MustCompile(name)
+	interceptRe  = regexp.MustCompile(`INTERCEPTED by (` + name + `)`)
+	fumbleRe     = regexp.MustCompile(`(?i:FUMBLES)(?: \((` + name + `)\))?`)
+	recoveredRe  = regexp.MustCompile(`(?i:RECOVERED by) ([A-Z]{2,3})-(` + name + `)`)
+	returnRe     = regexp.MustCompile(`^(` + name + `) (?:to|for|pushed|ran|runs)\b`)
+	fairCatchRe  = regexp.MustCompile(`(?i:fair catch by) (` + name + `)`)
+	penaltyRe    = regexp.MustCompile(`PENALTY on ([A-Z]{2,3})(?:-(` + name + `))?, ([^,]+), (\d+) yards?`)
+	noPenaltyYds = regexp.MustCompile(`PENALTY on ([A-Z]{2,3})(?:-(` + name + `))?, ([^,.]+)`)
+)
+
+// Parse reads one play's text
+func Parse(text string) Play {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\n", " "))
+	body := prefixRe.ReplaceAllString(text, "")
+	sentences := splitSentences(body)
+	main := ""
+	if len(sentences) > 0 {
+		main = sentences[0]
+	}
+
+	var p Play
+	p.Kind = KindOther
+	switch {
+	case timeoutRe.MatchString(main):
+		p.Kind = KindTimeout
+	case twoPointRe.MatchString(body):
+		parseScrimmage(&p, strings.TrimLeft(twoPointRe.Split(body, 2)[1], ". "))
+		p.Kind, p.Yards = KindTwoPoint, 0
+	default:
+		parseMain(&p, main)
+	}
+
+	if m := interceptRe.FindStringSubmatch(body); m != nil {
+		p.Interception = true
+		p.Interceptor = m[1]
+		p.Yards = 0
+		p.Incomplete = false
+	}
+	if m := fumbleRe.FindStringSubmatch(body); m != nil {
+		p.Fumble = true
+		p.ForcedBy = m[1]
+	}
+	if m := recoveredRe.FindStringSubmatch(body); m != nil {
+		p.RecoveryTeam = m[1]
+		p.RecoveredBy = m[2]
+	}
+	if m := fairCatchRe.FindStringSubmatch(body); m != nil {
+		p.FairCatch = true
+		p.Returner = m[1]
+	}
+
+	// A later sentence starting with a name is a return: of a kick, or by
+	// the defense after a turnover
+	if p.Kicker != "" || p.Interception || p.RecoveredBy != "" {
+		for _, s := range sentences[1:] {
+			m := returnRe.FindStringSubmatch(s)
+			if m == nil || p.Returner != "" {
+				continue
+			}
+			p.Returner = m[1]
+			p.ReturnYards = firstYards(s)
+			if t := lastTacklers(s); t != nil {
+				p.Tacklers = t
+			}
+		}
+	}
+	if p.Interception && p.Returner == "" {
+		p.Returner = p.Interceptor
+	}
+
+	upper := strings.ToUpper(body)
+	p.Touchdown = strings.Contains(upper, "TOUCHDOWN")
+	p.Safety = strings.Contains(upper, "SAFETY")
+	p.Touchback = strings.Contains(upper, "TOUCHBACK")
+	if strings.Contains(upper, "BLOCKED") {
+		p.Blocked = true
+	}
+
+	p.Penalty = parsePenalty(body)
+	if p.Kind == KindOther && p.Penalty != nil && strings.HasPrefix(main, "PENALTY") {
+		p.Kind = KindPenalty
+	}
+	return p
+}
+
+// parseMain fills in the play's main action from its first sentence
+func parseMain(p *Play, main string) {
+	switch {
+	case puntRe.MatchString(main):
+		m := puntRe.FindStringSubmatch(main)
+		p.Kind, p.Kicker, p.KickDistance = KindPunt, m[1], atoi(m[2])
+	case puntBlockRe.MatchString(main):
+		p.Kind, p.Kicker = KindPunt, puntBlockRe.FindStringSubmatch(main)[1]
+	case kickoffRe.MatchString(main):
+		m := kickoffRe.FindStringSubmatch(main)
+		p.Kind, p.Kicker, p.KickDistance = KindKickoff, m[1], atoi(m[2])
+	case fieldGoalRe.MatchString(main):
+		m := fieldGoalRe.FindStringSubmatch(main)
+		p.Kind, p.Kicker, p.KickDistance = KindFieldGoal, m[1], atoi(m[2])
+		p.KickGood = m[3] == "GOOD"
+	case extraPointRe.MatchString(main):
+		m := extraPointRe.FindStringSubmatch(main)
+		p.Kind, p.Kicker = KindExtraPoint, m[1]
+		p.KickGood = m[2] == "GOOD"
+	case kneelRe.MatchString(main):
+		p.Kind, p.Rusher = KindKneel, kneelRe.FindStringSubmatch(main)[1]
+		p.Yards = firstYards(main)
+	case spikeRe.MatchString(main):
+		p.Kind, p.Passer, p.Incomplete = KindSpike, spikeRe.FindStringSubmatch(main)[1], true
+	default:
+		parseScrimmage(p, main)
+	}
+}
+
+// parseScrimmage reads a pass, sack or run
+func parseScrimmage(p *Play, main string) {
+	switch {
+	case sackRe.MatchString(main):
+		p.Kind, p.Sack = KindSack, true
+		p.Passer = sackRe.FindStringSubmatch(main)[1]
+	case passRe.MatchString(main):
+		m := passRe.FindStringSubmatch(main)
+		p.Kind, p.Passer, p.Receiver = KindPass, m[1], m[3]
+		p.Incomplete = m[2] != "" || strings.Contains(main, "intended for") || strings.Contains(main, "incomplete")
+	case rushRe.MatchString(main):
+		p.Kind, p.Rusher = KindRush, rushRe.FindStringSubmatch(main)[1]
+	default:
+		return
+	}
+	if !p.Incomplete {
+		p.Yards = firstYards(main)
+	}
+	p.Tacklers = lastTacklers(main)
+}
+
+// parsePenalty reads the first flag in the text
+func parsePenalty(text string) *Penalty {
+	var pen *Penalty
+	if m := penaltyRe.FindStringSubmatch(text); m != nil {
+		pen = &Penalty{Team: m[1], Player: m[2], Type: strings.TrimSpace(m[3]), Yards: atoi(m[4])}
+	} else if m := noPenaltyYds.FindStringSubmatch(text); m != nil {
+		pen = &Penalty{Team: m[1], Player: m[2], Type: strings.TrimSpace(m[3])}
+	} else {
+		return nil
+	}
+	lower := strings.ToLower(text)
+	pen.NoPlay = strings.Contains(lower, "no play")
+	pen.Declined = strings.Contains(lower, "declined")
+	pen.Offsetting = strings.Contains(lower, "offsetting")
+	return pen
+}
+
+// splitSentences breaks play text at sentence ends
+func splitSentences(text string) []string {
+	var out []string
+	for {
+		loc := sentenceRe.FindStringIndex(text)
+		if loc == nil {
+			break
+		}
+		// Keep the capital that starts the next sentence
+		end := loc[0] + 1
+		next := loc[0] + 1
+		for next < len(text) && text[next] == ' ' {
+			next++
+		}
+		out = append(out, strings.TrimSpace(text[:end]))
+		text = text[next:]
+	}
+	if strings.TrimSpace(text) != "" {
+		out = append(out, strings.TrimSpace(text))
+	}
+	return out
+}
+
+// firstYards is the first "for N yards" in s; "for no gain" is 0
+func firstYards(s string) int {
+	m := yardsRe.FindStringSubmatch(s)
+	if m == nil || m[2] != "" {
+		return 0
+	}
+	return atoi(m[1])
+}
+
+// lastTacklers is the last parenthesized list of names in s
+func lastTacklers(s string) []string {
+	all := tacklersRe.FindAllStringSubmatch(s, -1)
+	if len(all) == 0 {
+		return nil
+	}
+	return nameRe.FindAllString(all[len(all)-1][1], -1)
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
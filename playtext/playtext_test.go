@@ -0,0 +1,219 @@
+package playtext
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want Play
+	}{
+		{
+			name: "complete pass",
+			text: "(14:20) (Shotgun) J.Allen pass short right to K.Shakir to BUF 44 for 13 yards (T.McDuffie).",
+			want: Play{Kind: KindPass, Passer: "J.Allen", Receiver: "K.Shakir", Yards: 13, Tacklers: []string{"T.McDuffie"}},
+		},
+		{
+			name: "incompletion with defender",
+			text: "(9:42) (Shotgun) P.Mahomes pass short right intended for X.Worthy (R.Douglas).",
+			want: Play{Kind: KindPass, Passer: "P.Mahomes", Receiver: "X.Worthy", Incomplete: true, Tacklers: []string{"R.Douglas"}},
+		},
+		{
+			name: "incompletion to A.St. Brown",
+			text: "(4:12) (Shotgun) J.Goff pass incomplete short left to A.St. Brown.",
+			want: Play{Kind: KindPass, Passer: "J.Goff", Receiver: "A.St. Brown", Incomplete: true},
+		},
+		{
+			name: "catch by A.St. Brown, two tacklers",
+			text: "(7:47) (Shotgun) J.Goff pass short middle to A.St. Brown to DET 41 for 11 yards (J.Ramsey; J.Hill).",
+			want: Play{Kind: KindPass, Passer: "J.Goff", Receiver: "A.St. Brown", Yards: 11, Tacklers: []string{"J.Ramsey", "J.Hill"}},
+		},
+		{
+			name: "passing touchdown and extra point",
+			text: "(10:31) (Shotgun) J.Allen pass short right to D.Kincaid for 18 yards, TOUCHDOWN. T.Bass extra point is GOOD, Center-R.Ferguson, Holder-S.Martin.",
+			want: Play{Kind: KindPass, Passer: "J.Allen", Receiver: "D.Kincaid", Yards: 18, Touchdown: true},
+		},
+		{
+			name: "run out of bounds",
+			text: "(13:12) Ja.Chase right end pushed ob at CIN 47 for 12 yards (D.Ward).",
+			want: Play{Kind: KindRush, Rusher: "Ja.Chase", Yards: 12, Tacklers: []string{"D.Ward"}},
+		},
+		{
+			name: "run for no gain",
+			text: "(8:44) J.Jacobs right guard to GB 45 for no gain (T.Edmunds).",
+			want: Play{Kind: KindRush, Rusher: "J.Jacobs", Tacklers: []string{"T.Edmunds"}},
+		},
+		{
+			name: "sack",
+			text: "(12:28) (Shotgun) J.Allen sacked at KC 43 for -7 yards (C.Jones).",
+			want: Play{Kind: KindSack, Passer: "J.Allen", Sack: true, Yards: -7, Tacklers: []string{"C.Jones"}},
+		},
+		{
+			name: "strip sack recovered by the defense",
+			text: "(2:14) (Shotgun) D.Prescott sacked at DAL 25 for -9 yards (B.Graham). FUMBLES (B.Graham), RECOVERED by PHI-J.Carter at DAL 22. J.Carter to DAL 22 for no gain.",
+			want: Play{Kind: KindSack, Passer: "D.Prescott", Sack: true, Yards: -9, Tacklers: []string{"B.Graham"},
+				Fumble: true, ForcedBy: "B.Graham", RecoveredBy: "J.Carter", RecoveryTeam: "PHI", Returner: "J.Carter"},
+		},
+		{
+			name: "interception with return",
+			text: "(7:35) (Shotgun) J.Allen pass deep middle intended for K.Coleman INTERCEPTED by J.Reid at KC 30. J.Reid to KC 41 for 11 yards (D.Knox).",
+			want: Play{Kind: KindPass, Passer: "J.Allen", Receiver: "K.Coleman", Interception: true, Interceptor: "J.Reid",
+				Returner: "J.Reid", ReturnYards: 11, Tacklers: []string{"D.Knox"}},
+		},
+		{
+			name: "pick six",
+			text: "(3:21) (Shotgun) B.Young pass short right intended for A.Thielen INTERCEPTED by D.Ward at CAR 34. D.Ward for 34 yards, TOUCHDOWN.",
+			want: Play{Kind: KindPass, Passer: "B.Young", Receiver: "A.Thielen", Interception: true, Interceptor: "D.Ward",
+				Returner: "D.Ward", ReturnYards: 34, Touchdown: true},
+		},
+		{
+			name: "fumble lost after a catch",
+			text: "(12:52) P.Mahomes pass short left to T.Kelce to BUF 45 for 8 yards (T.Bernard). FUMBLES (T.Bernard), RECOVERED by BUF-T.Johnson at BUF 42. T.Johnson to BUF 42 for no gain.",
+			want: Play{Kind: KindPass, Passer: "P.Mahomes", Receiver: "T.Kelce", Yards: 8, Tacklers: []string{"T.Bernard"},
+				Fumble: true, ForcedBy: "T.Bernard", RecoveredBy: "T.Johnson", RecoveryTeam: "BUF", Returner: "T.Johnson"},
+		},
+		{
+			name: "fumble recovered by the offense",
+			text: "(8:22) S.Barkley up the middle to PHI 40 for 3 yards (B.Wagner). FUMBLES (B.Wagner), RECOVERED by PHI-L.Johnson at PHI 41.",
+			want: Play{Kind: KindRush, Rusher: "S.Barkley", Yards: 3, Tacklers: []string{"B.Wagner"},
+				Fumble: true, ForcedBy: "B.Wagner", RecoveredBy: "L.Johnson", RecoveryTeam: "PHI"},
+		},
+		{
+			name: "field goal good",
+			text: "(14:09) T.Bass 45 yard field goal is GOOD, Center-R.Ferguson, Holder-S.Martin.",
+			want: Play{Kind: KindFieldGoal, Kicker: "T.Bass", KickDistance: 45, KickGood: true},
+		},
+		{
+			name: "field goal missed",
+			text: "(0:03) J.Tucker 56 yard field goal is No Good, Wide Right, Center-N.Moore, Holder-J.Stout.",
+			want: Play{Kind: KindFieldGoal, Kicker: "J.Tucker", KickDistance: 56},
+		},
+		{
+			name: "field goal blocked",
+			text: "(5:10) C.McLaughlin 44 yard field goal is BLOCKED (J.Jenkins), Center-Z.Triner, Holder-J.Camarda.",
+			want: Play{Kind: KindFieldGoal, Kicker: "C.McLaughlin", KickDistance: 44, Blocked: true},
+		},
+		{
+			name: "extra point missed",
+			text: "(3:03) J.Elliott extra point is No Good, Hit Left Upright, Center-J.Luciano, Holder-B.Anger.",
+			want: Play{Kind: KindExtraPoint, Kicker: "J.Elliott"},
+		},
+		{
+			name: "punt with return",
+			text: "(9:00) M.Araiza punts 48 yards to BUF 19, Center-J.Winchester. K.Shakir to BUF 27 for 8 yards (J.Cochrane).",
+			want: Play{Kind: KindPunt, Kicker: "M.Araiza", KickDistance: 48, Returner: "K.Shakir", ReturnYards: 8, Tacklers: []string{"J.Cochrane"}},
+		},
+		{
+			name: "punt fair catch",
+			text: "(6:30) M.Araiza punts 52 yards to BUF 21, Center-J.Winchester, fair catch by K.Shakir.",
+			want: Play{Kind: KindPunt, Kicker: "M.Araiza", KickDistance: 52, FairCatch: true, Returner: "K.Shakir"},
+		},
+		{
+			name: "punt touchback",
+			text: "(11:02) B.Kern punts 44 yards to end zone, Center-M.Orzech, Touchback.",
+			want: Play{Kind: KindPunt, Kicker: "B.Kern", KickDistance: 44, Touchback: true},
+		},
+		{
+			name: "blocked punt returned for a touchdown",
+			text: "(4:30) T.Way punt is BLOCKED by J.Jones, Center-T.Ott, RECOVERED by NE-M.Jones at WAS 12. M.Jones for 12 yards, TOUCHDOWN.",
+			want: Play{Kind: KindPunt, Kicker: "T.Way", Blocked: true, RecoveredBy: "M.Jones", RecoveryTeam: "NE",
+				Returner: "M.Jones", ReturnYards: 12, Touchdown: true},
+		},
+		{
+			name: "kickoff touchback",
+			text: "H.Butker kicks 65 yards from KC 35 to end zone, Touchback.",
+			want: Play{Kind: KindKickoff, Kicker: "H.Butker", KickDistance: 65, Touchback: true},
+		},
+		{
+			name: "kickoff return",
+			text: "H.Butker kicks 65 yards from KC 35 to BUF 0. R.Davis to BUF 28 for 28 yards (J.Smith).",
+			want: Play{Kind: KindKickoff, Kicker: "H.Butker", KickDistance: 65, Returner: "R.Davis", ReturnYards: 28, Tacklers: []string{"J.Smith"}},
+		},
+		{
+			name: "two-point pass",
+			text: "(0:50) TWO-POINT CONVERSION ATTEMPT. J.Hurts pass to A.Brown is complete. ATTEMPT SUCCEEDS.",
+			want: Play{Kind: KindTwoPoint, Passer: "J.Hurts", Receiver: "A.Brown"},
+		},
+		{
+			name: "two-point run",
+			text: "(1:12) TWO-POINT CONVERSION ATTEMPT. S.Barkley rushes up the middle. ATTEMPT FAILS.",
+			want: Play{Kind: KindTwoPoint, Rusher: "S.Barkley"},
+		},
+		{
+			name: "kneel",
+			text: "(0:38) P.Mahomes kneels to KC 44 for -1 yards.",
+			want: Play{Kind: KindKneel, Rusher: "P.Mahomes", Yards: -1},
+		},
+		{
+			name: "spike",
+			text: "(0:12) (No Huddle) J.Goff spiked the ball to stop the clock.",
+			want: Play{Kind: KindSpike, Passer: "J.Goff", Incomplete: true},
+		},
+		{
+			name: "safety",
+			text: "(2:02) (Shotgun) B.Nix sacked in end zone for -8 yards, SAFETY (N.Bosa).",
+			want: Play{Kind: KindSack, Passer: "B.Nix", Sack: true, Yards: -8, Safety: true, Tacklers: []string{"N.Bosa"}},
+		},
+		{
+			name: "declined penalty",
+			text: "(7:05) (Shotgun) J.Love pass short middle to J.Reed to GB 36 for 9 yards (K.Jackson). PENALTY on CHI-T.Edmunds, Defensive Holding, declined.",
+			want: Play{Kind: KindPass, Passer: "J.Love", Receiver: "J.Reed", Yards: 9, Tacklers: []string{"K.Jackson"},
+				Penalty: &Penalty{Team: "CHI", Player: "T.Edmunds", Type: "Defensive Holding", Declined: true}},
+		},
+		{
+			name: "offsetting penalties",
+			text: "(6:41) J.Jacobs left tackle to GB 30 for 2 yards (T.Edmunds). PENALTY on GB-J.Morgan, Offensive Holding, offsetting, enforced at GB 28 - No Play. PENALTY on CHI-M.Sweat, Unnecessary Roughness, offsetting.",
+			want: Play{Kind: KindRush, Rusher: "J.Jacobs", Yards: 2, Tacklers: []string{"T.Edmunds"},
+				Penalty: &Penalty{Team: "GB", Player: "J.Morgan", Type: "Offensive Holding", NoPlay: true, Offsetting: true}},
+		},
+		{
+			name: "completion wiped out by a penalty",
+			text: "(5:12) (Shotgun) C.Stroud pass short left to N.Collins to HOU 45 for 12 yards (J.Ramsey). PENALTY on HOU-L.Tunsil, Offensive Holding, 10 yards, enforced at HOU 33 - No Play.",
+			want: Play{Kind: KindPass, Passer: "C.Stroud", Receiver: "N.Collins", Yards: 12, Tacklers: []string{"J.Ramsey"},
+				Penalty: &Penalty{Team: "HOU", Player: "L.Tunsil", Type: "Offensive Holding", Yards: 10, NoPlay: true}},
+		},
+		{
+			name: "penalty only",
+			text: "(6:15) PENALTY on KC-J.Thuney, Offensive Holding, 10 yards, enforced at BUF 46 - No Play.",
+			want: Play{Kind: KindPenalty, Penalty: &Penalty{Team: "KC", Player: "J.Thuney", Type: "Offensive Holding", Yards: 10, NoPlay: true}},
+		},
+		{
+			name: "team penalty",
+			text: "(10:15) PENALTY on NYJ, False Start, 5 yards, enforced at NYJ 30 - No Play.",
+			want: Play{Kind: KindPenalty, Penalty: &Penalty{Team: "NYJ", Type: "False Start", Yards: 5, NoPlay: true}},
+		},
+		{
+			name: "timeout",
+			text: "Timeout #1 by KC at 01:15.",
+			want: Play{Kind: KindTimeout},
+		},
+		{
+			name: "end of quarter",
+			text: "END QUARTER 1",
+			want: Play{Kind: KindOther},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.text)
+			if len(got.Tacklers) == 0 {
+				got.Tacklers = nil
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q)\n got %+v%s\nwant %+v%s", tt.text, got, penalty(got), tt.want, penalty(tt.want))
+			}
+		})
+	}
+}
+
+// penalty spells out the flag, which %+v would print as a pointer
+func penalty(p Play) string {
+	if p.Penalty == nil {
+		return ""
+	}
+	return fmt.Sprintf(" penalty %+v", *p.Penalty)
+}
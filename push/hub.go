@@ -0,0 +1,312 @@
+// Package push fans live game updates out to many subscribers over
+// Server-Sent Events and WebSockets, polling upstream once per game
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"nfl-scores/models"
+	"nfl-scores/output"
+	"nfl-scores/service"
+)
+
+// AllLive subscribes to every game in progress
+const AllLive = "live"
+
+// subscriberBuffer is how many messages a subscriber may fall behind
+// before it is disconnected
+const subscriberBuffer = 64
+
+// Message names, used as the SSE event name
+const (
+	MessageSnapshot = "snapshot" // Full live summary, sent on subscribe and after each batch of events
+	MessageEvent    = "event"    // One detected game event
+	MessageError    = "error"    // The game could not be watched
+)
+
+// Message is one pushed update; Data is a JSON document from the output package
+type Message struct {
+	Name string
+	Data []byte
+}
+
+// Subscriber receives messages for one game or for all live games. C is
+// closed when the game ends, the subscriber falls too far behind, or the
+// hub shuts down.
+type Subscriber struct {
+	C      <-chan Message
+	ch     chan Message
+	gameID string
+	closed bool
+}
+
+// feed polls one game on behalf of all its subscribers
+type feed struct {
+	cancel context.CancelFunc
+	subs   map[*Subscriber]struct{}
+	live   bool                // Kept running by the all-live discovery
+	last   *models.GameSummary // Latest snapshot, sent to new subscribers
+}
+
+// Hub owns one upstream poller per watched game
+type Hub struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	service  *service.ScoreService
+	poll     service.PollConfig
+	discover time.Duration // How often the all-live scoreboard is checked
+
+	mu          sync.Mutex
+	feeds       map[string]*feed
+	allSubs     map[*Subscriber]struct{}
+	finished    map[string]struct{} // Games whose feed saw the final whistle
+	discovering bool
+}
+
+// NewHub creates a hub that polls through svc with the given cadence
+func NewHub(svc *service.ScoreService, poll service.PollConfig) *Hub {
+	ctx, cancel := context.WithCancel(context.Background())
+	discover := poll.MaxInterval
+	if discover <= 0 {
+		discover = service.DefaultMaxPollInterval
+	}
+
+	return &Hub{
+		ctx:      ctx,
+		cancel:   cancel,
+		service:  svc,
+		poll:     poll,
+		discover: discover,
+		feeds:    make(map[string]*feed),
+		allSubs:  make(map[*Subscriber]struct{}),
+		finished: make(map[string]struct{}),
+	}
+}
+
+// Subscribe starts receiving updates for gameID, or for every live game
+// when gameID is AllLive. The latest known snapshots are delivered first.
+func (h *Hub) Subscribe(gameID string) *Subscriber {
+	ch := make(chan Message, subscriberBuffer)
+	sub := &Subscriber{C: ch, ch: ch, gameID: gameID}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if gameID == AllLive {
+		h.allSubs[sub] = struct{}{}
+		for _, f := range h.feeds {
+			if f.last != nil {
+				h.send(sub, snapshotMessage(f.last))
+			}
+		}
+		if !h.discovering {
+			h.discovering = true
+			go h.discoverLoop()
+		}
+		return sub
+	}
+
+	f := h.feedLocked(gameID)
+	f.subs[sub] = struct{}{}
+	if f.last != nil {
+		h.send(sub, snapshotMessage(f.last))
+	}
+	return sub
+}
+
+// Unsubscribe stops delivery to sub; a game with no subscribers left stops
+// being polled
+func (h *Hub) Unsubscribe(sub *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closeLocked(sub)
+	delete(h.allSubs, sub)
+	if f, ok := h.feeds[sub.gameID]; ok {
+		delete(f.subs, sub)
+		h.releaseLocked(sub.gameID, f)
+	}
+}
+
+// Close stops every poller and disconnects all subscribers
+func (h *Hub) Close() {
+	h.cancel()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, f := range h.feeds {
+		for sub := range f.subs {
+			h.closeLocked(sub)
+		}
+	}
+	for sub := range h.allSubs {
+		h.closeLocked(sub)
+	}
+}
+
+// feedLocked returns the running feed for gameID, starting one if needed
+func (h *Hub) feedLocked(gameID string) *feed {
+	if f, ok := h.feeds[gameID]; ok {
+		return f
+	}
+	ctx, cancel := context.WithCancel(h.ctx)
+	f := &feed{cancel: cancel, subs: make(map[*Subscriber]struct{})}
+	h.feeds[gameID] = f
+	go h.run(ctx, gameID, f)
+	return f
+}
+
+// releaseLocked stops a feed nobody needs any more
+func (h *Hub) releaseLocked(gameID string, f *feed) {
+	if len(f.subs) == 0 && (!f.live || len(h.allSubs) == 0) {
+		f.cancel()
+		delete(h.feeds, gameID)
+	}
+}
+
+// run polls one game until it ends or its feed is released
+func (h *Hub) run(ctx context.Context, gameID string, f *feed) {
+	err := h.service.WatchGame(ctx, gameID, h.poll, func(summary *models.GameSummary, events []service.Event) error {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		first := f.last == nil
+		f.last = summary
+		if !first && len(events) == 0 {
+			return nil
+		}
+		snapshot := snapshotMessage(summary)
+		for _, sub := range h.recipientsLocked(f) {
+			for _, e := range events {
+				h.send(sub, eventMessage(e))
+			}
+			h.send(sub, snapshot)
+		}
+		return nil
+	})
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err == nil && f.last != nil && f.last.Game.Status == models.StatusFinal {
+		h.finished[gameID] = struct{}{}
+	}
+	if err != nil && ctx.Err() == nil {
+		msg := errorMessage(gameID, err)
+		for sub := range f.subs {
+			h.send(sub, msg)
+		}
+	}
+	// The game is over (or unwatchable): disconnect its own subscribers;
+	// all-live subscribers stay for the remaining games
+	for sub := range f.subs {
+		h.closeLocked(sub)
+	}
+	if h.feeds[gameID] == f {
+		delete(h.feeds, gameID)
+	}
+	f.cancel()
+}
+
+// discoverLoop keeps a feed running for every live game while anyone is
+// subscribed to all of them. Games that already ended are never restarted,
+// so all-live subscribers see one game_final per game.
+func (h *Hub) discoverLoop() {
+	for {
+		h.mu.Lock()
+		if len(h.allSubs) == 0 || h.ctx.Err() != nil {
+			h.discovering = false
+			for id, f := range h.feeds {
+				h.releaseLocked(id, f)
+			}
+			h.mu.Unlock()
+			return
+		}
+		h.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(service.Polling(h.ctx), h.discover)
+		games, err := h.service.GetLiveGames(ctx)
+		cancel()
+		if err == nil {
+			h.mu.Lock()
+			for _, g := range games {
+				// The scoreboard can lag the summary; an ended game stays ended
+				if _, done := h.finished[g.ID]; done {
+					continue
+				}
+				f := h.feedLocked(g.ID)
+				if !f.live && f.last != nil {
+					// Already watched for a single-game subscriber
+					for sub := range h.allSubs {
+						h.send(sub, snapshotMessage(f.last))
+					}
+				}
+				f.live = true
+			}
+			h.mu.Unlock()
+		}
+
+		select {
+		case <-h.ctx.Done():
+		case <-time.After(h.discover):
+		}
+	}
+}
+
+// recipientsLocked lists the game's own subscribers plus all-live ones
+func (h *Hub) recipientsLocked(f *feed) []*Subscriber {
+	subs := make([]*Subscriber, 0, len(f.subs)+len(h.allSubs))
+	for sub := range f.subs {
+		subs = append(subs, sub)
+	}
+	if f.live {
+		for sub := range h.allSubs {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// send delivers msg without blocking the poller; a subscriber that has
+// fallen a full buffer behind is disconnected
+func (h *Hub) send(sub *Subscriber, msg Message) {
+	if sub.closed {
+		return
+	}
+	select {
+	case sub.ch <- msg:
+	default:
+		h.closeLocked(sub)
+	}
+}
+
+func (h *Hub) closeLocked(sub *Subscriber) {
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+	}
+}
+
+func snapshotMessage(s *models.GameSummary) Message {
+	return Message{Name: MessageSnapshot, Data: encode(output.NewLiveSummary(s))}
+}
+
+func eventMessage(e service.Event) Message {
+	return Message{Name: MessageEvent, Data: encode(output.NewEvent(e))}
+}
+
+func errorMessage(gameID string, err error) Message {
+	return Message{Name: MessageError, Data: encode(map[string]string{"kind": MessageError, "game_id": gameID, "error": err.Error()})}
+}
+
+// encode writes v as single-line JSON, leaving "&" and friends unescaped
+// to match the output package
+func encode(v any) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(v)
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
@@ -0,0 +1,74 @@
+package push
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/service"
+)
+
+// fastPolls keeps the hub from sleeping between polls of the fake server
+var fastPolls = service.PollConfig{Interval: time.Millisecond, MinInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+// laggingScoreboard serves the scripted game, except that the scoreboard
+// keeps showing it in progress after it ends, as ESPN's sometimes does
+func laggingScoreboard(t *testing.T) *service.ScoreService {
+	t.Helper()
+	fake, err := fakeespn.New(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	fake.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fakeespn.ScoreboardPath, nil))
+	stale := rec.Body.Bytes()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == fakeespn.ScoreboardPath {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(stale)
+			return
+		}
+		fake.Handler().ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return service.NewScoreService(client.NewESPNClientWithBaseURL(srv.URL))
+}
+
+func TestHubDoesNotRestartFinishedGames(t *testing.T) {
+	hub := NewHub(laggingScoreboard(t), fastPolls)
+	defer hub.Close()
+	sub := hub.Subscribe(AllLive)
+
+	finals := 0
+	timeout := time.After(5 * time.Second)
+	var settle <-chan time.Time
+	for {
+		select {
+		case msg, ok := <-sub.C:
+			if !ok {
+				t.Fatal("all-live subscriber disconnected")
+			}
+			var doc struct {
+				Type string `json:"type"`
+			}
+			json.Unmarshal(msg.Data, &doc)
+			if msg.Name == MessageEvent && doc.Type == string(service.EventGameFinal) {
+				finals++
+				// Give discovery time to restart the game if it would
+				settle = time.After(200 * time.Millisecond)
+			}
+		case <-settle:
+			if finals != 1 {
+				t.Errorf("game_final sent %d times, want 1", finals)
+			}
+			return
+		case <-timeout:
+			t.Fatalf("timed out with %d game_final events", finals)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// heartbeatInterval keeps idle SSE connections open through proxies
+const heartbeatInterval = 15 * time.Second
+
+// Server exposes a Hub over Server-Sent Events and WebSockets
+type Server struct {
+	hub     *Hub
+	origins []string
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithAllowedOrigins lets browser pages from these origins (e.g.
+// "https://scores.example.com", or "*" for any) open WebSockets. Pages
+// served from the push server's own host are always allowed.
+func WithAllowedOrigins(origins ...string) Option {
+	return func(s *Server) {
+		s.origins = append(s.origins, origins...)
+	}
+}
+
+// NewServer creates a push server for hub
+func NewServer(hub *Hub, opts ...Option) *Server {
+	s := &Server{hub: hub}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Handler routes the push endpoints. game is a game ID or "live" (the
+// default) for every game in progress.
+//
+//	GET /events?game=ID|live   Server-Sent Events
+//	GET /ws?game=ID|live       WebSocket, one JSON document per text frame
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /events", s.handleEvents)
+	mux.HandleFunc("GET /ws", s.handleWebSocket)
+	return mux
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	sub := s.hub.Subscribe(gameParam(r))
+	defer s.hub.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		case msg, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", msg.Name, msg.Data)
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	if !s.originAllowed(r) {
+		http.Error(w, "origin not allowed", http.StatusForbidden)
+		return
+	}
+	ws, err := upgradeWebSocket(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer ws.Close()
+
+	sub := s.hub.Subscribe(gameParam(r))
+	defer s.hub.Unsubscribe(sub)
+
+	closed := make(chan struct{})
+	go func() {
+		ws.readLoop()
+		close(closed)
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case msg, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			if err := ws.WriteText(msg.Data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// originAllowed guards against cross-site WebSocket hijacking: browsers
+// always send Origin, and any page could otherwise open a socket here.
+// Clients that aren't browsers send no Origin and are let through.
+func (s *Server) originAllowed(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range s.origins {
+		allowed = strings.TrimRight(strings.TrimSpace(allowed), "/")
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// gameParam reads the subscription target, defaulting to all live games
+func gameParam(r *http.Request) string {
+	if id := r.URL.Query().Get("game"); id != "" {
+		return id
+	}
+	return AllLive
+}
@@ -0,0 +1,312 @@
+package push
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/service"
+)
+
+// sampleKey and sampleAccept are the handshake example from RFC 6455 section 1.3
+const (
+	sampleKey    = "dGhlIHNhbXBsZSBub25jZQ=="
+	sampleAccept = "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="
+)
+
+// pushServer serves a hub watching the scripted live game at the default
+// cadence, so its feed stays open for the length of a test
+func pushServer(t *testing.T, opts ...Option) *httptest.Server {
+	t.Helper()
+	fake, err := fakeespn.Start(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(fake.Close)
+	hub := NewHub(service.NewScoreService(client.NewESPNClientWithBaseURL(fake.URL())), service.DefaultPollConfig())
+	t.Cleanup(hub.Close)
+	srv := httptest.NewServer(NewServer(hub, opts...).Handler())
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// wsClient is the client end of a raw WebSocket connection
+type wsClient struct {
+	conn net.Conn
+	br   *bufio.Reader
+}
+
+// dial sends an opening handshake with extra headers and returns the response
+func dial(t *testing.T, srv *httptest.Server, header http.Header) (*wsClient, *http.Response) {
+	t.Helper()
+	conn, err := net.Dial("tcp", strings.TrimPrefix(srv.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/ws?game="+fakeespn.DefaultLiveGameID, nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", sampleKey)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if err := req.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &wsClient{conn: conn, br: br}, resp
+}
+
+// upgrade dials and fails the test unless the server switches protocols
+func upgrade(t *testing.T, srv *httptest.Server) *wsClient {
+	t.Helper()
+	c, resp := dial(t, srv, nil)
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("handshake status = %d, want 101", resp.StatusCode)
+	}
+	return c
+}
+
+// send writes one client frame; browsers always mask, so masked is the norm
+func (c *wsClient) send(t *testing.T, op byte, payload []byte, masked bool) {
+	t.Helper()
+	frame := []byte{0x80 | op, byte(len(payload))}
+	if masked {
+		mask := [4]byte{0x12, 0x34, 0x56, 0x78}
+		frame[1] |= 0x80
+		frame = append(frame, mask[:]...)
+		for i, b := range payload {
+			frame = append(frame, b^mask[i%4])
+		}
+	} else {
+		frame = append(frame, payload...)
+	}
+	if _, err := c.conn.Write(frame); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// frame is one server frame as it arrived
+type frame struct {
+	fin     bool
+	masked  bool
+	op      byte
+	payload []byte
+}
+
+func (c *wsClient) read(t *testing.T) frame {
+	t.Helper()
+	f, err := readServerFrame(c.br)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func readServerFrame(r io.Reader) (frame, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return frame{}, err
+	}
+	f := frame{fin: head[0]&0x80 != 0, op: head[0] & 0x0F, masked: head[1]&0x80 != 0}
+	n := uint64(head[1] & 0x7F)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return frame{}, err
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return frame{}, err
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	f.payload = make([]byte, n)
+	_, err := io.ReadFull(r, f.payload)
+	return f, err
+}
+
+func TestWebSocketHandshake(t *testing.T) {
+	_, resp := dial(t, pushServer(t), nil)
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("status = %d, want 101", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Sec-WebSocket-Accept"); got != sampleAccept {
+		t.Errorf("Sec-WebSocket-Accept = %q, want %q", got, sampleAccept)
+	}
+	if !headerContains(resp.Header, "Upgrade", "websocket") || !headerContains(resp.Header, "Connection", "upgrade") {
+		t.Errorf("upgrade headers = %v", resp.Header)
+	}
+}
+
+func TestWebSocketRejectsBadHandshakes(t *testing.T) {
+	srv := pushServer(t)
+	tests := []struct {
+		name   string
+		header http.Header
+	}{
+		{"old version", http.Header{"Sec-Websocket-Version": {"8"}}},
+		{"no key", http.Header{"Sec-Websocket-Key": {""}}},
+		{"no upgrade", http.Header{"Upgrade": {"h2c"}}},
+	}
+	for _, tt := range tests {
+		_, resp := dial(t, srv, tt.header)
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", tt.name, resp.StatusCode)
+		}
+	}
+}
+
+func TestWebSocketOrigin(t *testing.T) {
+	srv := pushServer(t, WithAllowedOrigins("https://scores.example.com/"))
+	tests := []struct {
+		origin string
+		want   int
+	}{
+		{"", http.StatusSwitchingProtocols}, // Not a browser
+		{srv.URL, http.StatusSwitchingProtocols},
+		{"https://scores.example.com", http.StatusSwitchingProtocols},
+		{"https://evil.example.com", http.StatusForbidden},
+		{"null", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.origin != "" {
+			header.Set("Origin", tt.origin)
+		}
+		if _, resp := dial(t, srv, header); resp.StatusCode != tt.want {
+			t.Errorf("Origin %q: status = %d, want %d", tt.origin, resp.StatusCode, tt.want)
+		}
+	}
+
+	open := pushServer(t, WithAllowedOrigins("*"))
+	if _, resp := dial(t, open, http.Header{"Origin": {"https://evil.example.com"}}); resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("with * allowed: status = %d, want 101", resp.StatusCode)
+	}
+}
+
+func TestWebSocketSnapshotFrame(t *testing.T) {
+	c := upgrade(t, pushServer(t))
+
+	f := c.read(t)
+	if !f.fin || f.op != opText || f.masked {
+		t.Fatalf("frame fin=%v op=%#x masked=%v, want an unmasked final text frame", f.fin, f.op, f.masked)
+	}
+	var doc struct {
+		Kind string `json:"kind"`
+		Game struct {
+			ID string `json:"id"`
+		} `json:"game"`
+	}
+	if err := json.Unmarshal(f.payload, &doc); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if doc.Kind != "live_summary" || doc.Game.ID != fakeespn.DefaultLiveGameID {
+		t.Errorf("first document = %s %s, want the game's live_summary", doc.Kind, doc.Game.ID)
+	}
+}
+
+func TestWebSocketPing(t *testing.T) {
+	c := upgrade(t, pushServer(t))
+	c.send(t, opPing, []byte("are you there"), true)
+
+	for {
+		f := c.read(t)
+		if f.op == opText {
+			continue // Snapshots may arrive first
+		}
+		if f.op != opPong || string(f.payload) != "are you there" {
+			t.Fatalf("got op %#x %q, want a pong echoing the ping", f.op, f.payload)
+		}
+		return
+	}
+}
+
+func TestWebSocketClose(t *testing.T) {
+	c := upgrade(t, pushServer(t))
+	c.send(t, opClose, []byte{0x03, 0xE8}, true)
+	expectClose(t, c)
+}
+
+func TestWebSocketRejectsUnmaskedFrames(t *testing.T) {
+	c := upgrade(t, pushServer(t))
+	c.send(t, opPing, []byte("x"), false)
+	expectClose(t, c)
+}
+
+func TestWebSocketRejectsOversizedControlFrames(t *testing.T) {
+	c := upgrade(t, pushServer(t))
+	// A 126-byte ping needs the extended length form, which control frames may not use
+	payload := bytes.Repeat([]byte("x"), 126)
+	mask := []byte{1, 2, 3, 4}
+	frame := append([]byte{0x80 | opPing, 0x80 | 126, 0, 126}, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	c.conn.Write(frame)
+	expectClose(t, c)
+}
+
+// expectClose reads until the server's close frame, which must carry a
+// normal closure code, then expects the connection to end
+func expectClose(t *testing.T, c *wsClient) {
+	t.Helper()
+	for {
+		f, err := readServerFrame(c.br)
+		if err != nil {
+			t.Fatalf("connection ended without a close frame: %v", err)
+		}
+		if f.op != opClose {
+			continue
+		}
+		if len(f.payload) < 2 || binary.BigEndian.Uint16(f.payload) != 1000 {
+			t.Errorf("close payload = %v, want code 1000", f.payload)
+		}
+		break
+	}
+	if _, err := c.br.ReadByte(); err != io.EOF {
+		t.Errorf("read after close = %v, want EOF", err)
+	}
+}
+
+func TestWriteFrameLengths(t *testing.T) {
+	for _, n := range []int{0, 125, 126, 0xFFFF, 0x10000} {
+		server, clientEnd := net.Pipe()
+		ws := &wsConn{conn: server, rw: bufio.NewReadWriter(bufio.NewReader(server), bufio.NewWriter(server))}
+		payload := bytes.Repeat([]byte("a"), n)
+		go func() {
+			ws.WriteText(payload)
+			server.Close()
+		}()
+
+		f, err := readServerFrame(clientEnd)
+		if err != nil {
+			t.Fatalf("%d bytes: %v", n, err)
+		}
+		if !f.fin || f.op != opText || f.masked || !bytes.Equal(f.payload, payload) {
+			t.Errorf("%d bytes: got fin=%v op=%#x masked=%v len=%d", n, f.fin, f.op, f.masked, len(f.payload))
+		}
+		clientEnd.Close()
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"nfl-scores/push"
+	"nfl-scores/service"
+)
+
+// runPushServer streams live game events to subscribers over SSE and WebSockets
+func runPushServer(args []string) {
+	fs := flag.NewFlagSet("push-server", flag.ExitOnError)
+	addr := fs.String("addr", ":8081", "Address to listen on")
+	allowOrigin := fs.String("allow-origin", "", "Comma-separated browser origins allowed to open WebSockets (* for any)")
+	espn := addClientFlags(fs)
+	fs.Parse(args)
+
+	var opts []push.Option
+	if *allowOrigin != "" {
+		opts = append(opts, push.WithAllowedOrigins(strings.Split(*allowOrigin, ",")...))
+	}
+
+	hub := push.NewHub(service.NewScoreService(newESPNClient(espn)), service.DefaultPollConfig())
+	defer hub.Close()
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           push.NewServer(hub, opts...).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Printf("NFL push server listening on %s\n", *addr)
+	fmt.Println("Endpoints: /events?game=ID|live (SSE)  /ws?game=ID|live (WebSocket)")
+	if err := httpServer.ListenAndServe(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
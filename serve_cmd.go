@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"nfl-scores/api"
+	"nfl-scores/service"
+)
+
+// runServe exposes ScoreService as a local REST API
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "Address to listen on")
+	espn := addClientFlags(fs)
+	fs.Parse(args)
+
+	srv := api.NewServer(service.NewScoreService(newESPNClient(espn)))
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           srv.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Printf("NFL scores API listening on %s\n", *addr)
+	fmt.Println("Endpoints: /scoreboard?dates=  /games/{id}/summary  /games/{id}/replay  /games/{id}/stats")
+	if err := httpServer.ListenAndServe(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
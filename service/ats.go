@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"nfl-scores/models"
+)
+
+// GetATSReport settles the finished games in a date range against their
+// lines. The scoreboard drops the line from some finished games, so those
+// are looked up in each game's summary.
+func (s *ScoreService) GetATSReport(ctx context.Context, dates string) (models.ATSReport, error) {
+	games, err := s.GetScoresByDates(ctx, dates)
+	if err != nil {
+		return models.ATSReport{}, err
+	}
+
+	errs := make([]error, len(games))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, scheduleFetchers)
+	for i := range games {
+		if games[i].Status != models.StatusFinal || games[i].Odds != nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			summary, err := s.GetGameSummary(ctx, games[i].ID)
+			if err != nil {
+				errs[i] = fmt.Errorf("game %s: %w", games[i].ID, err)
+				return
+			}
+			if summary != nil {
+				games[i].Odds = summary.Game.Odds
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return models.ATSReport{}, err
+		}
+	}
+	return ATSReport(games), nil
+}
+
+// ATSReport tallies each team's record against the spread and the total
+// over the finished games that had a line
+func ATSReport(games []models.Game) models.ATSReport {
+	var report models.ATSReport
+	report.Favorites.Team = "FAV"
+	report.Favorites.Name = "Favorites"
+	teams := make(map[string]*models.ATSRecord)
+	record := func(t models.Team) *models.ATSRecord {
+		if teams[t.Abbreviation] == nil {
+			teams[t.Abbreviation] = &models.ATSRecord{Team: t.Abbreviation, Name: t.Name}
+		}
+		return teams[t.Abbreviation]
+	}
+
+	for _, g := range games {
+		res, ok := g.LineResult()
+		if !ok {
+			continue
+		}
+		report.Games++
+		fav := g.Favorite()
+		for _, t := range []models.Team{g.AwayTeam, g.HomeTeam} {
+			r := record(t)
+			if res.HasSpread {
+				tallyCover(r, res, t.Abbreviation)
+			}
+			tallyTotal(r, res.TotalLine)
+		}
+		if res.HasSpread && fav != "" {
+			tallyCover(&report.Favorites, res, fav)
+		}
+		tallyTotal(&report.Favorites, res.TotalLine)
+	}
+
+	for _, r := range teams {
+		report.Teams = append(report.Teams, *r)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool {
+		a, b := report.Teams[i], report.Teams[j]
+		if a.CoverRate() != b.CoverRate() {
+			return a.CoverRate() > b.CoverRate()
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		return a.Team < b.Team
+	})
+	return report
+}
+
+// tallyCover adds one spread result to team's record
+func tallyCover(r *models.ATSRecord, res models.LineResult, team string) {
+	switch {
+	case res.Push:
+		r.Pushes++
+	case res.Covered == team:
+		r.Wins++
+	default:
+		r.Losses++
+	}
+}
+
+// tallyTotal adds one over/under result to a record
+func tallyTotal(r *models.ATSRecord, total string) {
+	switch total {
+	case models.TotalOver:
+		r.Overs++
+	case models.TotalUnder:
+		r.Unders++
+	case models.TotalPush:
+		r.TotalPushes++
+	}
+}
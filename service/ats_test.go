@@ -0,0 +1,63 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"nfl-scores/models"
+)
+
+func TestATSReport(t *testing.T) {
+	game := func(away string, awayScore int, home string, homeScore int, odds *models.Odds) models.Game {
+		return models.Game{
+			Status:   models.StatusFinal,
+			AwayTeam: models.Team{Abbreviation: away, Name: away, Score: awayScore},
+			HomeTeam: models.Team{Abbreviation: home, Name: home, Score: homeScore},
+			Odds:     odds,
+		}
+	}
+	live := game("NYJ", 7, "NE", 3, &models.Odds{Spread: -3})
+	live.Status = models.StatusInProgress
+
+	report := ATSReport([]models.Game{
+		// BUF -2.5 wins by 9: favorite covers, 51 goes over 45.5
+		game("KC", 21, "BUF", 30, &models.Odds{Spread: -2.5, OverUnder: 45.5}),
+		// Spread's sign says DET is the underdog, the flag says favorite;
+		// DET -3 wins by 3 on the nose
+		game("DET", 27, "CHI", 24, &models.Odds{Spread: -3, AwayFavorite: true, OverUnder: 51}),
+		// Pick'em: no favorite, KC covers by winning
+		game("KC", 20, "DEN", 17, &models.Odds{Details: "EVEN", OverUnder: 41}),
+		// No line, and a game still going: both skipped
+		game("MIA", 10, "BUF", 13, nil),
+		live,
+	})
+
+	if report.Games != 3 {
+		t.Errorf("Games = %d, want 3", report.Games)
+	}
+	fav := report.Favorites
+	if fav.Wins != 1 || fav.Losses != 0 || fav.Pushes != 1 {
+		t.Errorf("favorites ATS = %s, want 1-0-1", fav)
+	}
+	if fav.Overs != 1 || fav.Unders != 1 || fav.TotalPushes != 1 {
+		t.Errorf("favorites O/U = %s, want 1-1-1", fav.TotalString())
+	}
+
+	want := map[string]string{"KC": "1-1", "BUF": "1-0", "DET": "0-0-1", "CHI": "0-0-1", "DEN": "0-1"}
+	if len(report.Teams) != len(want) {
+		t.Errorf("%d teams, want %d", len(report.Teams), len(want))
+	}
+	for _, r := range report.Teams {
+		if r.String() != want[r.Team] {
+			t.Errorf("%s ATS = %s, want %s", r.Team, r, want[r.Team])
+		}
+	}
+	// Best cover rate first, then most covers, then by name
+	var order []string
+	for _, r := range report.Teams {
+		order = append(order, r.Team)
+	}
+	if got := strings.Join(order, ","); got != "BUF,KC,CHI,DEN,DET" {
+		t.Errorf("order = %s, want BUF,KC,CHI,DEN,DET", got)
+	}
+}
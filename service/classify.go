@@ -0,0 +1,81 @@
+package service
+
+import (
+	"strings"
+
+	"nfl-scores/models"
+	"nfl-scores/playtext"
+)
+
+// PlayKind is what a play did to the game beyond moving the ball
+type PlayKind string
+
+// Play kinds, most significant first when a play could be several
+const (
+	PlayRoutine         PlayKind = ""
+	PlaySafety          PlayKind = "safety"
+	PlayInterception    PlayKind = "interception"
+	PlayFumbleLost      PlayKind = "fumble_lost"
+	PlayTurnoverOnDowns PlayKind = "turnover_on_downs"
+	PlayPunt            PlayKind = "punt"
+	PlayKickoff         PlayKind = "kickoff"
+	PlayPenalty         PlayKind = "penalty"
+)
+
+// IsTurnover reports whether the kind gives the ball to the defense
+func (k PlayKind) IsTurnover() bool {
+	return k == PlayInterception || k == PlayFumbleLost || k == PlayTurnoverOnDowns
+}
+
+// ChangesPossession reports whether the other team has the ball after a play
+// of this kind, by design or not
+func (k PlayKind) ChangesPossession() bool {
+	return k.IsTurnover() || k == PlayPunt || k == PlayKickoff || k == PlaySafety
+}
+
+// ClassifyPlay tells what a play did from ESPN's play type, the parsed play
+// text and who had the ball before and after it
+func ClassifyPlay(p models.Play) PlayKind {
+	t := strings.ToLower(p.Type)
+	d := p.Details
+	flipped := p.EndPossession != "" && p.Possession != "" && p.EndPossession != p.Possession
+
+	switch {
+	case strings.Contains(t, "safety") || d.Safety:
+		return PlaySafety
+	case strings.Contains(t, "interception") || d.Interception:
+		return PlayInterception
+	case strings.Contains(t, "fumble recovery (opponent)") || strings.Contains(t, "opp fumble recovery"):
+		return PlayFumbleLost
+	case strings.Contains(t, "punt") || d.Kind == playtext.KindPunt:
+		return PlayPunt
+	case strings.Contains(t, "kickoff") || d.Kind == playtext.KindKickoff:
+		// A kickoff fumbled to the kicking team shows up under the receiving team's drive
+		if d.Fumble && d.RecoveryTeam != "" && p.Possession != "" && d.RecoveryTeam != p.Possession {
+			return PlayFumbleLost
+		}
+		return PlayKickoff
+	case d.Fumble && flipped:
+		return PlayFumbleLost
+	case p.StartDown == 4 && flipped && !p.ScoringPlay && !strings.Contains(t, "field goal") &&
+		d.Kind != playtext.KindFieldGoal && !strings.Contains(t, "penalty"):
+		// Stopped on fourth down; a missed field goal also hands over the ball
+		// but isn't a turnover
+		return PlayTurnoverOnDowns
+	case strings.Contains(t, "penalty") || (d.Penalty != nil && !d.Penalty.Declined && !d.Penalty.Offsetting):
+		return PlayPenalty
+	}
+	return PlayRoutine
+}
+
+// ClassifyReplayPlay is ClassifyPlay for a play in a replay
+func ClassifyReplayPlay(p models.ReplayPlay) PlayKind {
+	return ClassifyPlay(models.Play{
+		Type:          p.Type,
+		ScoringPlay:   p.ScoringPlay,
+		Possession:    p.Possession,
+		StartDown:     p.StartDown,
+		EndPossession: p.EndPossession,
+		Details:       p.Details,
+	})
+}
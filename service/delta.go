@@ -0,0 +1,86 @@
+package service
+
+import "nfl-scores/models"
+
+// SummaryDelta describes what changed between two consecutive GameSummary snapshots
+type SummaryDelta struct {
+	NewPlays          []models.Play // Plays not present in the previous snapshot, oldest first
+	ScoreChanged      bool
+	HomePoints        int // Points scored by the home team since the previous snapshot
+	AwayPoints        int // Points scored by the away team since the previous snapshot
+	PossessionChanged bool
+	PrevPossession    string
+	Possession        string
+	PeriodChanged     bool
+	PrevPeriod        int
+	Period            int
+	StatusChanged     bool
+	PrevStatus        models.GameStatus
+	Status            models.GameStatus
+}
+
+// Empty reports whether nothing changed
+func (d SummaryDelta) Empty() bool {
+	return len(d.NewPlays) == 0 && !d.ScoreChanged && !d.PossessionChanged &&
+		!d.PeriodChanged && !d.StatusChanged
+}
+
+// DiffSummaries compares two consecutive snapshots of the same game.
+// A nil prev (the first poll) or nil curr yields an empty delta.
+func DiffSummaries(prev, curr *models.GameSummary) SummaryDelta {
+	var d SummaryDelta
+	if prev == nil || curr == nil {
+		return d
+	}
+
+	// Score
+	d.HomePoints = curr.Game.HomeTeam.Score - prev.Game.HomeTeam.Score
+	d.AwayPoints = curr.Game.AwayTeam.Score - prev.Game.AwayTeam.Score
+	d.ScoreChanged = d.HomePoints != 0 || d.AwayPoints != 0
+
+	// Status
+	d.PrevStatus = prev.Game.Status
+	d.Status = curr.Game.Status
+	d.StatusChanged = d.PrevStatus != d.Status
+
+	// Possession and period come from the latest play
+	if prev.CurrentPlay != nil {
+		d.PrevPossession = prev.CurrentPlay.Possession
+		d.PrevPeriod = prev.CurrentPlay.Period
+	}
+	if curr.CurrentPlay != nil {
+		d.Possession = curr.CurrentPlay.Possession
+		d.Period = curr.CurrentPlay.Period
+	}
+	d.PossessionChanged = d.PrevPossession != "" && d.Possession != "" && d.PrevPossession != d.Possession
+	d.PeriodChanged = d.PrevPeriod != 0 && d.Period != 0 && d.PrevPeriod != d.Period
+
+	// New plays: anything not seen in the previous snapshot. The full log
+	// catches plays from drives that ended between polls; without it, fall
+	// back to RecentPlays, walked backwards to report oldest first.
+	seen := make(map[string]bool, len(prev.Plays)+len(prev.RecentPlays)+1)
+	for _, p := range prev.Plays {
+		seen[p.ID] = true
+	}
+	for _, p := range prev.RecentPlays {
+		seen[p.ID] = true
+	}
+	if prev.CurrentPlay != nil {
+		seen[prev.CurrentPlay.ID] = true
+	}
+	if len(curr.Plays) > 0 {
+		for _, p := range curr.Plays {
+			if !seen[p.ID] {
+				d.NewPlays = append(d.NewPlays, p)
+			}
+		}
+	} else {
+		for i := len(curr.RecentPlays) - 1; i >= 0; i-- {
+			if p := curr.RecentPlays[i]; !seen[p.ID] {
+				d.NewPlays = append(d.NewPlays, p)
+			}
+		}
+	}
+
+	return d
+}
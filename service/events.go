@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"nfl-scores/models"
+)
+
+// EventType names a notable change in a live game
+type EventType string
+
+// Event types, in the order they are reported within one poll
+const (
+	EventNewPlay          EventType = "new_play"
+	EventScoringPlay      EventType = "scoring_play"
+	EventTurnover         EventType = "turnover"
+	EventPossessionChange EventType = "possession_change"
+	EventTwoMinuteWarning EventType = "two_minute_warning"
+	EventQuarterEnd       EventType = "quarter_end"
+	EventStatusChange     EventType = "status_change"
+	EventGameFinal        EventType = "game_final"
+)
+
+// Event is one notable change detected between two snapshots of a game
+type Event struct {
+	Type           EventType
+	GameID         string
+	At             time.Time    // When the change was detected
+	Play           *models.Play // The play behind the event, if known
+	Period         int
+	Clock          string
+	HomeScore      int
+	AwayScore      int
+	Possession     string
+	PrevPossession string   // Set for possession changes and turnovers
+	PlayKind       PlayKind // What Play did, e.g. an interception or punt
+}
+
+// DetectEvents lists what happened between prev and curr, oldest first.
+// Play-level events come from the new plays themselves; score, possession
+// and period changes whose play fell outside the current drive are still
+// reported from the delta.
+func DetectEvents(prev, curr *models.GameSummary, d SummaryDelta, now time.Time) []Event {
+	if prev == nil || curr == nil {
+		return nil
+	}
+
+	var events []Event
+	base := Event{
+		GameID:     curr.Game.ID,
+		At:         now,
+		HomeScore:  curr.Game.HomeTeam.Score,
+		AwayScore:  curr.Game.AwayTeam.Score,
+		Possession: d.Possession,
+	}
+	if n := len(curr.Plays); n > 0 {
+		base.Period = curr.Plays[n-1].Period
+		base.Clock = curr.Plays[n-1].Clock
+	} else if curr.CurrentPlay != nil {
+		base.Period = curr.CurrentPlay.Period
+		base.Clock = curr.CurrentPlay.Clock
+	}
+	add := func(t EventType, p *models.Play) {
+		e := base
+		e.Type = t
+		if t == EventPossessionChange || t == EventTurnover {
+			e.PrevPossession = d.PrevPossession
+		}
+		if p != nil {
+			e.Play = p
+			e.PlayKind = ClassifyPlay(*p)
+			e.Period = p.Period
+			e.Clock = p.Clock
+		}
+		events = append(events, e)
+	}
+
+	var sawScore, sawTurnover, sawWarning, sawQuarterEnd bool
+	for i := range d.NewPlays {
+		p := &d.NewPlays[i]
+		add(EventNewPlay, p)
+		// A pick-six or fumble return touchdown is both
+		if p.ScoringPlay {
+			add(EventScoringPlay, p)
+			sawScore = true
+		}
+		if ClassifyPlay(*p).IsTurnover() {
+			add(EventTurnover, p)
+			sawTurnover = true
+		}
+		switch t := strings.ToLower(p.Type); {
+		case strings.Contains(t, "two-minute warning"):
+			add(EventTwoMinuteWarning, p)
+			sawWarning = true
+		case strings.Contains(t, "end period"), strings.Contains(t, "end of half"):
+			add(EventQuarterEnd, p)
+			sawQuarterEnd = true
+		}
+	}
+
+	if d.ScoreChanged && !sawScore {
+		add(EventScoringPlay, nil)
+	}
+	if d.PossessionChanged {
+		// A change of possession nothing else explains is a turnover we
+		// didn't see a play for (e.g. on downs)
+		if !sawTurnover && !d.ScoreChanged && !explainedChange(curr, d) {
+			add(EventTurnover, nil)
+		}
+		add(EventPossessionChange, nil)
+	}
+	if !sawWarning && crossedTwoMinutes(prev, curr) {
+		add(EventTwoMinuteWarning, nil)
+	}
+	if d.PeriodChanged && !sawQuarterEnd && !endsPeriod(prev) {
+		e := base
+		e.Type = EventQuarterEnd
+		e.Period = d.PrevPeriod
+		e.Clock = "0:00"
+		events = append(events, e)
+	}
+	if d.StatusChanged {
+		add(EventStatusChange, nil)
+		if d.Status == models.StatusFinal {
+			add(EventGameFinal, nil)
+		}
+	}
+	return events
+}
+
+// explainedChange reports whether a change of possession is accounted for by
+// a punt, kickoff, safety, score, halftime or an already reported turnover, judging
+// by the new plays and the last play of the team that lost the ball
+func explainedChange(curr *models.GameSummary, d SummaryDelta) bool {
+	plays := append([]models.Play(nil), d.NewPlays...)
+	for i := len(curr.Plays) - 1; i >= 0; i-- {
+		if curr.Plays[i].Possession == d.PrevPossession {
+			plays = append(plays, curr.Plays[i])
+			break
+		}
+	}
+	for _, p := range plays {
+		if p.ScoringPlay || ClassifyPlay(p).ChangesPossession() ||
+			strings.Contains(strings.ToLower(p.Type), "end of half") {
+			return true
+		}
+	}
+	return false
+}
+
+// endsPeriod reports whether the last play already seen marked the end of a
+// quarter or half, so the period change has been reported
+func endsPeriod(s *models.GameSummary) bool {
+	var last *models.Play
+	if n := len(s.Plays); n > 0 {
+		last = &s.Plays[n-1]
+	} else {
+		last = s.CurrentPlay
+	}
+	if last == nil {
+		return false
+	}
+	t := strings.ToLower(last.Type)
+	return strings.Contains(t, "end period") || strings.Contains(t, "end of half")
+}
+
+// crossedTwoMinutes reports whether the clock passed 2:00 of the second or
+// fourth quarter between snapshots
+func crossedTwoMinutes(prev, curr *models.GameSummary) bool {
+	p, c := prev.CurrentPlay, curr.CurrentPlay
+	if p == nil || c == nil || p.Period != c.Period || (c.Period != 2 && c.Period != 4) {
+		return false
+	}
+	return clockSeconds(p.Clock) > 120 && clockSeconds(c.Clock) <= 120
+}
+
+// WatchEvents polls gameID on the adaptive schedule and calls emit for each
+// detected event until the game is final or ctx is cancelled
+func (s *ScoreService) WatchEvents(ctx context.Context, gameID string, cfg PollConfig, emit func(Event) error) error {
+	return s.WatchGame(ctx, gameID, cfg, func(_ *models.GameSummary, events []Event) error {
+		for _, e := range events {
+			if err := emit(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WatchGame polls gameID on the adaptive schedule and calls update with
+// every snapshot and the events detected since the previous one, until the
+// game is final or ctx is cancelled. A failed first fetch is returned; later
+// failures are retried like the live view does.
+func (s *ScoreService) WatchGame(ctx context.Context, gameID string, cfg PollConfig, update func(*models.GameSummary, []Event) error) error {
+	ctx = Polling(ctx)
+	poll := NewPollScheduler(cfg)
+	var prev *models.GameSummary
+
+	for {
+		var wait time.Duration
+		curr, err := s.GetGameSummary(ctx, gameID)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil && prev == nil:
+			return err
+		case err != nil:
+			wait = poll.Retry()
+		default:
+			now := time.Now()
+			delta := DiffSummaries(prev, curr)
+			events := DetectEvents(prev, curr, delta, now)
+			if prev == nil && curr.Game.Status == models.StatusFinal {
+				// Started after the whistle; still tell the consumer
+				events = append(events, Event{
+					Type:      EventGameFinal,
+					GameID:    curr.Game.ID,
+					At:        now,
+					HomeScore: curr.Game.HomeTeam.Score,
+					AwayScore: curr.Game.AwayTeam.Score,
+				})
+			}
+			if err := update(curr, events); err != nil {
+				return err
+			}
+			if curr.Game.Status == models.StatusFinal {
+				return nil
+			}
+			prev = curr
+			wait = poll.Next(curr, delta, now)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/models"
+)
+
+// fastPolls keeps WatchGame from sleeping between polls of the fake server
+var fastPolls = PollConfig{Interval: time.Millisecond, MinInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+// fakeService serves the bundled game through a real ESPNClient, scripted as
+// live when playsPerPoll is not negative
+func fakeService(t *testing.T, playsPerPoll int) *ScoreService {
+	t.Helper()
+	var opts []fakeespn.Option
+	if playsPerPoll >= 0 {
+		opts = append(opts, fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, playsPerPoll))
+	}
+	srv, err := fakeespn.Start(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	return NewScoreService(client.NewESPNClientWithBaseURL(srv.URL()))
+}
+
+// finalGame is the bundled game as it ended
+func finalGame(t *testing.T) *models.GameSummary {
+	t.Helper()
+	s, err := fakeService(t, -1).GetGameSummary(context.Background(), fakeespn.DefaultLiveGameID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestGetLiveGames(t *testing.T) {
+	games, err := fakeService(t, 1).GetLiveGames(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 1 || games[0].ID != fakeespn.DefaultLiveGameID {
+		t.Fatalf("live games = %v, want only the scripted game", games)
+	}
+}
+
+func TestDiffSummariesCatchesEveryPlay(t *testing.T) {
+	final := finalGame(t)
+	svc := fakeService(t, 0) // A whole drive per poll
+	ctx := context.Background()
+
+	prev, err := svc.GetGameSummary(ctx, fakeespn.DefaultLiveGameID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make([]string, 0, len(final.Plays))
+	for _, p := range prev.Plays {
+		seen = append(seen, p.ID)
+	}
+	for prev.Game.Status != models.StatusFinal {
+		curr, err := svc.GetGameSummary(ctx, fakeespn.DefaultLiveGameID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := DiffSummaries(prev, curr)
+		if len(d.NewPlays) == 0 && curr.Game.Status != models.StatusFinal {
+			t.Fatalf("poll after play %d found no new plays", len(seen))
+		}
+		for _, p := range d.NewPlays {
+			seen = append(seen, p.ID)
+		}
+		if got := d.HomePoints + prev.Game.HomeTeam.Score; got != curr.Game.HomeTeam.Score {
+			t.Errorf("home points %d don't add up to %d", got, curr.Game.HomeTeam.Score)
+		}
+		prev = curr
+	}
+
+	if len(seen) != len(final.Plays) {
+		t.Fatalf("saw %d plays, want %d", len(seen), len(final.Plays))
+	}
+	for i, p := range final.Plays {
+		if seen[i] != p.ID {
+			t.Fatalf("play %d is %s, want %s: plays missed or out of order", i, seen[i], p.ID)
+		}
+	}
+}
+
+func TestDiffSummariesFirstPoll(t *testing.T) {
+	if d := DiffSummaries(nil, finalGame(t)); !d.Empty() {
+		t.Errorf("first poll delta = %+v, want empty", d)
+	}
+}
+
+func TestDetectEventsPickSix(t *testing.T) {
+	pass := models.Play{ID: "1", Type: "Pass Reception", Period: 2, Possession: "KC", AwayScore: 21, HomeScore: 23}
+	pick := models.Play{
+		ID:          "2",
+		Type:        "Interception Return Touchdown",
+		Text:        "P.Mahomes pass short left INTERCEPTED by T.Bernard at KC 30. T.Bernard for 30 yards, TOUCHDOWN.",
+		Period:      2,
+		Possession:  "BUF",
+		AwayScore:   21,
+		HomeScore:   29,
+		ScoringPlay: true,
+	}
+	prev := &models.GameSummary{Plays: []models.Play{pass}, CurrentPlay: &pass}
+	prev.Game.AwayTeam.Score, prev.Game.HomeTeam.Score = 21, 23
+	curr := &models.GameSummary{Plays: []models.Play{pass, pick}, CurrentPlay: &pick}
+	curr.Game.AwayTeam.Score, curr.Game.HomeTeam.Score = 21, 29
+
+	got := map[EventType]int{}
+	for _, e := range DetectEvents(prev, curr, DiffSummaries(prev, curr), time.Now()) {
+		got[e.Type]++
+		if e.Type == EventTurnover && (e.Play == nil || e.Play.ID != "2") {
+			t.Errorf("turnover not attributed to the pick-six: %+v", e)
+		}
+	}
+	if got[EventScoringPlay] != 1 || got[EventTurnover] != 1 {
+		t.Errorf("pick-six events = %v, want one scoring_play and one turnover", got)
+	}
+}
+
+func TestWatchGameEvents(t *testing.T) {
+	final := finalGame(t)
+	svc := fakeService(t, 3)
+
+	counts := make(map[EventType]int)
+	kinds := make(map[PlayKind]int)
+	var first *models.GameSummary
+	var last Event
+	err := svc.WatchGame(context.Background(), fakeespn.DefaultLiveGameID, fastPolls, func(s *models.GameSummary, events []Event) error {
+		if first == nil {
+			first = s
+		}
+		for _, e := range events {
+			counts[e.Type]++
+			if e.Type == EventTurnover && e.Play != nil {
+				kinds[e.PlayKind]++
+			}
+			last = e
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Everything after the first snapshot is reported exactly once
+	wantScores, wantTurnovers := 0, make(map[PlayKind]int)
+	for _, p := range final.Plays[len(first.Plays):] {
+		if p.ScoringPlay {
+			wantScores++
+		}
+		if ClassifyPlay(p).IsTurnover() {
+			wantTurnovers[ClassifyPlay(p)]++
+		}
+	}
+	if got, want := counts[EventNewPlay], len(final.Plays)-len(first.Plays); got != want {
+		t.Errorf("new_play events = %d, want %d", got, want)
+	}
+	if counts[EventScoringPlay] != wantScores {
+		t.Errorf("scoring_play events = %d, want %d", counts[EventScoringPlay], wantScores)
+	}
+	for _, k := range []PlayKind{PlayInterception, PlayFumbleLost, PlayTurnoverOnDowns} {
+		if kinds[k] != wantTurnovers[k] {
+			t.Errorf("%s turnovers = %d, want %d", k, kinds[k], wantTurnovers[k])
+		}
+	}
+	if kinds[PlayInterception] == 0 || kinds[PlayTurnoverOnDowns] == 0 {
+		t.Errorf("turnover kinds = %v, want the fixture's interceptions and turnover on downs", kinds)
+	}
+	if counts[EventGameFinal] != 1 {
+		t.Errorf("game_final events = %d, want 1", counts[EventGameFinal])
+	}
+	if last.Type != EventGameFinal || last.HomeScore != final.Game.HomeTeam.Score || last.AwayScore != final.Game.AwayTeam.Score {
+		t.Errorf("last event = %s %d-%d, want game_final %d-%d", last.Type, last.AwayScore, last.HomeScore,
+			final.Game.AwayTeam.Score, final.Game.HomeTeam.Score)
+	}
+}
+
+func TestWatchGameThroughDiskCache(t *testing.T) {
+	srv, err := fakeespn.Start(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	c := client.NewESPNClient(client.WithBaseURL(srv.URL()), client.WithCache(client.NewCache(t.TempDir())))
+
+	// Polls far inside the cache's live TTL must still see every play
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	final := false
+	err = NewScoreService(c).WatchEvents(ctx, fakeespn.DefaultLiveGameID, fastPolls, func(e Event) error {
+		final = final || e.Type == EventGameFinal
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !final {
+		t.Error("game never reached final through the cache")
+	}
+}
+
+func TestWatchGameAfterFinal(t *testing.T) {
+	var events []Event
+	err := fakeService(t, -1).WatchEvents(context.Background(), fakeespn.DefaultLiveGameID, fastPolls, func(e Event) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != EventGameFinal {
+		t.Errorf("events = %v, want a single game_final", events)
+	}
+}
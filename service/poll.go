@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/models"
+)
+
+// Default live polling cadence
+const (
+	DefaultPollInterval    = 10 * time.Second
+	DefaultMinPollInterval = 3 * time.Second
+	DefaultMaxPollInterval = 60 * time.Second
+)
+
+// playRateWindow is how far back new plays are counted to detect a fast pace
+const playRateWindow = 2 * time.Minute
+
+// Polling marks ctx for live polling. Fetches made with it skip the response
+// cache's freshness window and revalidate with ESPN instead, so a cached
+// body never hides plays from a scheduler polling faster than the cache's
+// live TTL.
+func Polling(ctx context.Context) context.Context {
+	return client.Revalidate(ctx)
+}
+
+// PollConfig bounds the live polling cadence. Setting all three to the same
+// value gives a fixed interval.
+type PollConfig struct {
+	Interval    time.Duration // Baseline cadence during normal play
+	MinInterval time.Duration // Fastest cadence, used in two-minute drills
+	MaxInterval time.Duration // Slowest cadence, used at halftime and after the final
+}
+
+// DefaultPollConfig returns the standard polling cadence
+func DefaultPollConfig() PollConfig {
+	return PollConfig{
+		Interval:    DefaultPollInterval,
+		MinInterval: DefaultMinPollInterval,
+		MaxInterval: DefaultMaxPollInterval,
+	}
+}
+
+// PollScheduler picks the delay before the next live poll from the game
+// situation and how quickly plays have been arriving
+type PollScheduler struct {
+	cfg        PollConfig
+	playTimes  []time.Time // When recent new plays were first seen
+	idlePolls  int         // Consecutive polls with no change
+	lastChosen time.Duration
+}
+
+// NewPollScheduler creates a scheduler, filling in defaults for unset bounds
+func NewPollScheduler(cfg PollConfig) *PollScheduler {
+	def := DefaultPollConfig()
+	if cfg.Interval <= 0 {
+		cfg.Interval = def.Interval
+	}
+	if cfg.MinInterval <= 0 {
+		cfg.MinInterval = min(def.MinInterval, cfg.Interval)
+	}
+	if cfg.MaxInterval <= 0 {
+		cfg.MaxInterval = max(def.MaxInterval, cfg.Interval)
+	}
+	return &PollScheduler{cfg: cfg, lastChosen: cfg.Interval}
+}
+
+// Current returns the most recently chosen interval
+func (s *PollScheduler) Current() time.Duration {
+	return s.lastChosen
+}
+
+// Retry returns the delay after a failed poll
+func (s *PollScheduler) Retry() time.Duration {
+	s.lastChosen = s.cfg.Interval
+	return s.lastChosen
+}
+
+// Next records the latest snapshot and returns the delay before the next poll
+func (s *PollScheduler) Next(summary *models.GameSummary, delta SummaryDelta, now time.Time) time.Duration {
+	s.track(delta, now)
+	s.lastChosen = s.clamp(s.choose(summary))
+	return s.lastChosen
+}
+
+func (s *PollScheduler) track(delta SummaryDelta, now time.Time) {
+	if delta.Empty() {
+		s.idlePolls++
+	} else {
+		s.idlePolls = 0
+	}
+
+	for range delta.NewPlays {
+		s.playTimes = append(s.playTimes, now)
+	}
+	cutoff := now.Add(-playRateWindow)
+	kept := s.playTimes[:0]
+	for _, t := range s.playTimes {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	s.playTimes = kept
+}
+
+func (s *PollScheduler) choose(summary *models.GameSummary) time.Duration {
+	if summary == nil {
+		return s.cfg.Interval
+	}
+
+	switch summary.Game.Status {
+	case models.StatusFinal, models.StatusScheduled:
+		return s.cfg.MaxInterval
+	}
+
+	// Halftime and other long breaks
+	status := strings.ToLower(summary.Game.StatusText)
+	if strings.Contains(status, "halftime") || strings.Contains(status, "delayed") {
+		return s.cfg.MaxInterval
+	}
+
+	if p := summary.CurrentPlay; p != nil {
+		// Two-minute drill at the end of either half or in overtime
+		if (p.Period == 2 || p.Period >= 4) && clockSeconds(p.Clock) < 120 && !isBreak(p.Type) {
+			return s.cfg.MinInterval
+		}
+		// Timeouts, reviews and quarter breaks
+		if isBreak(p.Type) {
+			return s.cfg.Interval * 2
+		}
+	}
+
+	// Hurry-up pace: several plays a minute
+	if len(s.playTimes) >= 8 {
+		return s.cfg.Interval / 2
+	}
+
+	// Nothing happening; ease off gradually
+	if s.idlePolls >= 3 {
+		return s.cfg.Interval * time.Duration(1+s.idlePolls/3)
+	}
+
+	return s.cfg.Interval
+}
+
+func (s *PollScheduler) clamp(d time.Duration) time.Duration {
+	return max(s.cfg.MinInterval, min(d, s.cfg.MaxInterval))
+}
+
+// isBreak reports whether an ESPN play type marks a stoppage
+func isBreak(playType string) bool {
+	t := strings.ToLower(playType)
+	for _, marker := range []string{"timeout", "end period", "end of half", "two-minute warning", "review"} {
+		if strings.Contains(t, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// clockSeconds parses an "M:SS" game clock, treating unknown values as a full quarter
+func clockSeconds(clock string) int {
+	minStr, secStr, ok := strings.Cut(clock, ":")
+	if !ok {
+		return 15 * 60
+	}
+	mins, err1 := strconv.Atoi(minStr)
+	secs, err2 := strconv.Atoi(secStr)
+	if err1 != nil || err2 != nil {
+		return 15 * 60
+	}
+	return mins*60 + secs
+}
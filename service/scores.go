@@ -1,30 +1,32 @@
 package service
 
 import (
+	"context"
+
 	"nfl-scores/client"
 	"nfl-scores/models"
 )
 
 // ScoreService orchestrates fetching and processing of score data
 type ScoreService struct {
-	client *client.ESPNClient
+	client client.Provider
 }
 
-// NewScoreService creates a new score service instance
-func NewScoreService(c *client.ESPNClient) *ScoreService {
+// NewScoreService creates a new score service instance backed by any data provider
+func NewScoreService(c client.Provider) *ScoreService {
 	return &ScoreService{
 		client: c,
 	}
 }
 
 // GetCurrentScores retrieves and processes current NFL scores
-func (s *ScoreService) GetCurrentScores() ([]models.Game, error) {
-	return s.GetScoresByDates("")
+func (s *ScoreService) GetCurrentScores(ctx context.Context) ([]models.Game, error) {
+	return s.GetScoresByDates(ctx, "")
 }
 
 // GetScoresByDates retrieves NFL scores for a date range (format: YYYYMMDD-YYYYMMDD)
-func (s *ScoreService) GetScoresByDates(dates string) ([]models.Game, error) {
-	response, err := s.client.FetchScoreboardByDates(dates)
+func (s *ScoreService) GetScoresByDates(ctx context.Context, dates string) ([]models.Game, error) {
+	response, err := s.client.FetchScoreboardByDates(ctx, dates)
 	if err != nil {
 		return nil, err
 	}
@@ -33,8 +35,8 @@ func (s *ScoreService) GetScoresByDates(dates string) ([]models.Game, error) {
 }
 
 // GetLiveGames returns only games that are currently in progress
-func (s *ScoreService) GetLiveGames() ([]models.Game, error) {
-	games, err := s.GetCurrentScores()
+func (s *ScoreService) GetLiveGames(ctx context.Context) ([]models.Game, error) {
+	games, err := s.GetCurrentScores(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,8 +51,8 @@ func (s *ScoreService) GetLiveGames() ([]models.Game, error) {
 }
 
 // GetGameSummary retrieves detailed game info with play-by-play
-func (s *ScoreService) GetGameSummary(gameID string) (*models.GameSummary, error) {
-	response, err := s.client.FetchGameSummary(gameID)
+func (s *ScoreService) GetGameSummary(ctx context.Context, gameID string) (*models.GameSummary, error) {
+	response, err := s.client.FetchGameSummary(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
@@ -59,11 +61,22 @@ func (s *ScoreService) GetGameSummary(gameID string) (*models.GameSummary, error
 }
 
 // GetGameReplay retrieves full game data for replay mode
-func (s *ScoreService) GetGameReplay(gameID string) (*models.GameReplay, error) {
-	return s.client.FetchGameReplay(gameID)
+func (s *ScoreService) GetGameReplay(ctx context.Context, gameID string) (*models.GameReplay, error) {
+	return s.client.FetchGameReplay(ctx, gameID)
 }
 
 // GetGameStats retrieves game statistics
-func (s *ScoreService) GetGameStats(gameID string) (*models.GameStats, error) {
-	return s.client.FetchGameStats(gameID)
+func (s *ScoreService) GetGameStats(ctx context.Context, gameID string) (*models.GameStats, error) {
+	return s.client.FetchGameStats(ctx, gameID)
+}
+
+// GetGameDetails retrieves a game's summary, replay and stats from a single
+// fetch; all three are nil when ESPN has no such game
+func (s *ScoreService) GetGameDetails(ctx context.Context, gameID string) (*models.GameSummary, *models.GameReplay, *models.GameStats, error) {
+	response, err := s.client.FetchGameSummary(ctx, gameID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return response.ToGameSummary(), response.ToGameReplay(), response.ToGameStats(), nil
 }
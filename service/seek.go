@@ -0,0 +1,74 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"nfl-scores/models"
+)
+
+// gameTimeRe matches "Q3 5:30", "3 5:30", "OT 8:00" or just "Q4"
+var gameTimeRe = regexp.MustCompile(`(?i)^\s*(?:q\s*)?([1-5]|ot\d?)\s*(\d{1,2}:\d{2})?\s*$`)
+
+// ParseGameTime reads a period and game clock like "Q3 5:30" into the
+// period and seconds left in it. A period alone means its start; "OT" is
+// period 5 and "OT2" period 6.
+func ParseGameTime(s string) (period, secondsLeft int, err error) {
+	m := gameTimeRe.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, fmt.Errorf("can't read %q; try Q3 5:30", s)
+	}
+	switch p := strings.ToLower(m[1]); {
+	case p == "ot":
+		period = 5
+	case strings.HasPrefix(p, "ot"):
+		n, _ := strconv.Atoi(p[2:])
+		if n < 1 {
+			return 0, 0, fmt.Errorf("no period %s", m[1])
+		}
+		period = 4 + n
+	default:
+		period, _ = strconv.Atoi(p)
+	}
+	if period < 1 {
+		return 0, 0, fmt.Errorf("no period %s", m[1])
+	}
+	secondsLeft = 15 * 60
+	if m[2] != "" {
+		secondsLeft = clockSeconds(m[2])
+		if secondsLeft > 15*60 {
+			return 0, 0, fmt.Errorf("%s is more than a quarter", m[2])
+		}
+	}
+	return period, secondsLeft, nil
+}
+
+// PlayAtTime is the first play of period with secondsLeft or less on the
+// clock, or the period's last play if none is. It returns -1 when the game
+// has no plays in that period.
+func PlayAtTime(plays []models.ReplayPlay, period, secondsLeft int) int {
+	last := -1
+	for i, p := range plays {
+		if p.Period != period {
+			continue
+		}
+		if clockSeconds(p.Clock) <= secondsLeft {
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+// SeekPlay is the nearest play after from (step 1) or before it (step -1)
+// that match accepts, or -1
+func SeekPlay(plays []models.ReplayPlay, from, step int, match func(models.ReplayPlay) bool) int {
+	for i := from + step; i >= 0 && i < len(plays); i += step {
+		if match(plays[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,40 @@
+package service
+
+import "testing"
+
+func TestParseGameTime(t *testing.T) {
+	tests := []struct {
+		in          string
+		period      int
+		secondsLeft int
+		fails       bool
+	}{
+		{in: "Q3 5:30", period: 3, secondsLeft: 330},
+		{in: "3 5:30", period: 3, secondsLeft: 330},
+		{in: " q4 ", period: 4, secondsLeft: 900},
+		{in: "Q1 15:00", period: 1, secondsLeft: 900},
+		{in: "Q2 0:07", period: 2, secondsLeft: 7},
+		{in: "OT 8:00", period: 5, secondsLeft: 480},
+		{in: "ot1", period: 5, secondsLeft: 900},
+		{in: "OT2 1:15", period: 6, secondsLeft: 75},
+		{in: "OT0", fails: true},
+		{in: "OT0 5:00", fails: true},
+		{in: "Q0", fails: true},
+		{in: "Q6", fails: true},
+		{in: "Q3 15:01", fails: true},
+		{in: "halftime", fails: true},
+		{in: "", fails: true},
+	}
+	for _, tt := range tests {
+		period, secondsLeft, err := ParseGameTime(tt.in)
+		if tt.fails {
+			if err == nil {
+				t.Errorf("ParseGameTime(%q) = %d, %d; want an error", tt.in, period, secondsLeft)
+			}
+			continue
+		}
+		if err != nil || period != tt.period || secondsLeft != tt.secondsLeft {
+			t.Errorf("ParseGameTime(%q) = %d, %d, %v; want %d, %d", tt.in, period, secondsLeft, err, tt.period, tt.secondsLeft)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"nfl-scores/calendar"
+	"nfl-scores/models"
+)
+
+// scheduleFetchers bounds concurrent week fetches for a season schedule
+const scheduleFetchers = 4
+
+// GetTeamSchedule returns every game team plays in a season, regular season
+// and postseason, in kickoff order. It fetches the scoreboard week by week.
+func (s *ScoreService) GetTeamSchedule(ctx context.Context, season int, team string) ([]models.TeamGame, error) {
+	weeks := calendar.Weeks(season)
+	found := make([][]models.TeamGame, len(weeks))
+	errs := make([]error, len(weeks))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, scheduleFetchers)
+	for i, week := range weeks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			games, err := s.GetScoresByDates(ctx, week.Dates())
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", week.Label(), err)
+				return
+			}
+			for _, g := range games {
+				switch {
+				case strings.EqualFold(g.HomeTeam.Abbreviation, team):
+					found[i] = append(found[i], models.TeamGame{Week: week, Game: g, Home: true})
+				case strings.EqualFold(g.AwayTeam.Abbreviation, team):
+					found[i] = append(found[i], models.TeamGame{Week: week, Game: g})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	var schedule []models.TeamGame
+	seen := make(map[string]bool)
+	for i := range weeks {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for _, g := range found[i] {
+			if !seen[g.Game.ID] {
+				seen[g.Game.ID] = true
+				schedule = append(schedule, g)
+			}
+		}
+	}
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].Game.StartTime.Before(schedule[j].Game.StartTime)
+	})
+	return schedule, nil
+}
+
+// Record tallies the finished games of a schedule
+func Record(schedule []models.TeamGame) models.TeamRecord {
+	var r models.TeamRecord
+	streak := 0
+	for _, g := range schedule {
+		res := g.Result()
+		if res == "" {
+			continue
+		}
+		us, them := g.Team().Score, g.Opponent().Score
+		r.PointsFor += us
+		r.PointsAgainst += them
+
+		w, l, t := &r.AwayWins, &r.AwayLosses, &r.AwayTies
+		if g.Home {
+			w, l, t = &r.HomeWins, &r.HomeLosses, &r.HomeTies
+		}
+		switch res {
+		case "W":
+			r.Wins++
+			*w++
+		case "L":
+			r.Losses++
+			*l++
+		default:
+			r.Ties++
+			*t++
+		}
+
+		if r.Streak == "" || r.Streak[:1] != res {
+			streak = 0
+		}
+		streak++
+		r.Streak = fmt.Sprintf("%s%d", res, streak)
+	}
+	return r
+}
+
+// SeasonPart keeps the games of one part of the season
+func SeasonPart(schedule []models.TeamGame, typ calendar.SeasonType) []models.TeamGame {
+	var part []models.TeamGame
+	for _, g := range schedule {
+		if g.Week.Type == typ {
+			part = append(part, g)
+		}
+	}
+	return part
+}
+
+// NextGame is the first game of the schedule that hasn't finished, or nil
+func NextGame(schedule []models.TeamGame) *models.TeamGame {
+	for i := range schedule {
+		if schedule[i].Game.Status != models.StatusFinal {
+			return &schedule[i]
+		}
+	}
+	return nil
+}
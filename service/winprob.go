@@ -0,0 +1,49 @@
+package service
+
+import (
+	"math"
+	"sort"
+
+	"nfl-scores/models"
+)
+
+// WinSwing is a play that moved the home team's win probability
+type WinSwing struct {
+	Index  int     // Index into the replay's plays
+	Change float64 // Home win probability after the play minus before, -1 to 1
+}
+
+// HomeWinSeries is the home team's win probability after every play, 0-1.
+// Plays ESPN has no number for carry the previous value (0.5 before the
+// first).
+func HomeWinSeries(plays []models.ReplayPlay) []float64 {
+	series := make([]float64, len(plays))
+	last := 0.5
+	for i, p := range plays {
+		if p.WinProbability != nil {
+			last = p.WinProbability.Home
+		}
+		series[i] = last
+	}
+	return series
+}
+
+// BiggestSwings returns the n plays that moved the win probability most,
+// in game order
+func BiggestSwings(plays []models.ReplayPlay, n int) []WinSwing {
+	series := HomeWinSeries(plays)
+	var swings []WinSwing
+	for i := 1; i < len(series); i++ {
+		if change := series[i] - series[i-1]; change != 0 {
+			swings = append(swings, WinSwing{Index: i, Change: change})
+		}
+	}
+	sort.SliceStable(swings, func(i, j int) bool {
+		return math.Abs(swings[i].Change) > math.Abs(swings[j].Change)
+	})
+	if len(swings) > n {
+		swings = swings[:n]
+	}
+	sort.Slice(swings, func(i, j int) bool { return swings[i].Index < swings[j].Index })
+	return swings
+}
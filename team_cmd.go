@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"nfl-scores/calendar"
+	"nfl-scores/formatter"
+	"nfl-scores/service"
+)
+
+const teamHelpText = `Usage:
+  nfl-scores team ABBR [VIEW] [--season YEAR] [--tz ZONE] [--plain]
+
+Views:
+  (none)     Record and next game
+  schedule   Every game of the season, with byes
+  results    Finished games with scores
+  record     Overall, home and away record, points and streak
+  next       Next kickoff in local time
+
+Examples:
+  nfl-scores team KC
+  nfl-scores team DET next
+  nfl-scores team KC results --season 2023
+`
+
+// runTeamCommand shows one team's season
+func runTeamCommand(args []string) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		fmt.Print(teamHelpText)
+		os.Exit(1)
+	}
+	team := strings.ToUpper(args[0])
+	args = args[1:]
+	view := ""
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		view, args = args[0], args[1:]
+	}
+	switch view {
+	case "", "schedule", "results", "record", "next":
+	default:
+		fmt.Print(teamHelpText)
+		os.Exit(1)
+	}
+
+	userConfig := loadUserConfig()
+	fs := flag.NewFlagSet("team", flag.ExitOnError)
+	season := fs.Int("season", calendar.SeasonOf(time.Now()), "Season year (the year it started)")
+	plain := fs.Bool("plain", userConfig.Plain, "Disable colors and icons")
+	espn := addClientFlags(fs)
+	tz := fs.String("tz", "", "Time zone for kickoff times (IANA name)")
+	fs.Parse(args)
+
+	svc := service.NewScoreService(newESPNClient(espn))
+	f := formatter.NewTerminalFormatter(80, *plain).WithLocation(kickoffZone(*tz, userConfig))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	schedule, err := svc.GetTeamSchedule(ctx, *season, team)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, f.FormatError(err))
+		os.Exit(1)
+	}
+	if len(schedule) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no games found for %s in the %d season\n", team, *season)
+		os.Exit(1)
+	}
+
+	regular := service.Record(service.SeasonPart(schedule, calendar.Regular))
+	playoffs := service.Record(service.SeasonPart(schedule, calendar.Postseason))
+	next := service.NextGame(schedule)
+
+	switch view {
+	case "schedule":
+		fmt.Print(f.FormatTeamSchedule(team, *season, schedule))
+	case "results":
+		fmt.Print(f.FormatTeamResults(team, *season, schedule))
+	case "record":
+		fmt.Print(f.FormatTeamRecord(team, *season, regular, playoffs, schedule))
+	case "next":
+		fmt.Print(f.FormatNextGame(team, *season, next, time.Now()))
+	default:
+		fmt.Print(f.FormatTeamRecord(team, *season, regular, playoffs, schedule))
+		fmt.Println()
+		fmt.Print(f.FormatNextGame(team, *season, next, time.Now()))
+	}
+}
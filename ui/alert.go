@@ -0,0 +1,219 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// alertFor is how long a play banner stays up
+const alertFor = 4 * time.Second
+
+// alertLook is how the live view announces one kind of play: a title, a
+// color and an animation cycled beside it
+type alertLook struct {
+	title  string
+	color  string
+	frames []string
+	flash  bool // Alternate the banner's background
+}
+
+var alertLooks = map[service.PlayKind]alertLook{
+	service.PlayInterception: {
+		title:  "INTERCEPTED!",
+		color:  "196",
+		frames: []string{"   ◀", "  ◀◀", " ◀◀◀", "◀◀◀◀"},
+		flash:  true,
+	},
+	service.PlayFumbleLost: {
+		title:  "FUMBLE! BALL LOST",
+		color:  "202",
+		frames: []string{"●   ", " ●  ", "  ● ", "   ●", "  ● ", " ●  "},
+		flash:  true,
+	},
+	service.PlayTurnoverOnDowns: {
+		title:  "TURNOVER ON DOWNS",
+		color:  "201",
+		frames: []string{"1   ", "12  ", "123 ", "123✗"},
+		flash:  true,
+	},
+	service.PlaySafety: {
+		title:  "SAFETY!",
+		color:  "226",
+		frames: []string{"◆◇◆◇", "◇◆◇◆"},
+		flash:  true,
+	},
+	service.PlayPunt: {
+		title:  "PUNT",
+		color:  "39",
+		frames: []string{"●       ", " ⌒●     ", "   ⌒●   ", "     ⌒● ", "       ●"},
+	},
+	service.PlayKickoff: {
+		title:  "KICKOFF",
+		color:  "40",
+		frames: []string{"▲●      ", "▲ ─●    ", "▲ ──●   ", "▲ ───●  ", "▲ ────● "},
+	},
+	service.PlayPenalty: {
+		title:  "FLAG ON THE PLAY",
+		color:  "220",
+		frames: []string{"⚑", "⚐"},
+	},
+}
+
+// alertRank orders play kinds by how much they matter, for polls that
+// bring several notable plays
+var alertRank = map[service.PlayKind]int{
+	service.PlaySafety:          7,
+	service.PlayInterception:    6,
+	service.PlayFumbleLost:      5,
+	service.PlayTurnoverOnDowns: 4,
+	service.PlayPenalty:         3,
+	service.PlayPunt:            2,
+	service.PlayKickoff:         1,
+}
+
+// strongestPlay is the most notable new play among events; routine and nil
+// when none stands out
+func strongestPlay(events []service.Event) (service.PlayKind, *models.Play) {
+	kind, play := service.PlayRoutine, (*models.Play)(nil)
+	for _, e := range events {
+		if e.Type == service.EventNewPlay && alertRank[e.PlayKind] > alertRank[kind] {
+			kind, play = e.PlayKind, e.Play
+		}
+	}
+	return kind, play
+}
+
+// alertDetail says who did what on the play behind a banner
+func alertDetail(kind service.PlayKind, p *models.Play) string {
+	if p == nil {
+		return ""
+	}
+	d := p.Details
+	switch kind {
+	case service.PlayInterception:
+		switch {
+		case d.Interceptor != "" && d.Passer != "":
+			return fmt.Sprintf("%s picks off %s", d.Interceptor, d.Passer)
+		case d.Interceptor != "":
+			return "Picked off by " + d.Interceptor
+		}
+	case service.PlayFumbleLost:
+		carrier := d.Receiver
+		if carrier == "" {
+			carrier = d.Rusher
+		}
+		text := "Fumble"
+		if carrier != "" {
+			text += " by " + carrier
+		}
+		if d.RecoveryTeam != "" {
+			text += fmt.Sprintf(", recovered by %s", d.RecoveryTeam)
+			if d.RecoveredBy != "" {
+				text += " (" + d.RecoveredBy + ")"
+			}
+		}
+		return text
+	case service.PlayTurnoverOnDowns:
+		if p.EndPossession != "" {
+			return fmt.Sprintf("%s stopped on 4th down, %s ball", p.Possession, p.EndPossession)
+		}
+		return p.Possession + " stopped on 4th down"
+	case service.PlaySafety:
+		return p.Possession + " tackled in its own end zone"
+	case service.PlayPunt, service.PlayKickoff:
+		if d.Kicker == "" {
+			break
+		}
+		verb := "punts"
+		if kind == service.PlayKickoff {
+			verb = "kicks"
+		}
+		text := fmt.Sprintf("%s %s %d yards", d.Kicker, verb, d.KickDistance)
+		switch {
+		case d.Touchback:
+			text += ", touchback"
+		case d.FairCatch:
+			text += ", fair catch by " + d.Returner
+		case d.Returner != "":
+			text += fmt.Sprintf(", %s returns it %d", d.Returner, d.ReturnYards)
+		}
+		return text
+	case service.PlayPenalty:
+		pen := d.Penalty
+		if pen == nil {
+			break
+		}
+		text := pen.Team + " " + pen.Type
+		if pen.Yards > 0 {
+			text += fmt.Sprintf(", %d yards", pen.Yards)
+		}
+		if pen.Player != "" {
+			text += " (" + pen.Player + ")"
+		}
+		if pen.NoPlay {
+			text += ", no play"
+		}
+		return text
+	}
+	return ""
+}
+
+// renderAlert draws the banner for the play behind an alert at the given
+// animation frame
+func renderAlert(kind service.PlayKind, p *models.Play, frame int, plain bool) string {
+	look, ok := alertLooks[kind]
+	if !ok {
+		return ""
+	}
+	detail := alertDetail(kind, p)
+	if plain {
+		line := "  >>> " + look.title
+		if detail != "" {
+			line += " - " + detail
+		}
+		return line + " <<<\n"
+	}
+
+	banner := lipgloss.NewStyle().Bold(true).Padding(0, 1).
+		Foreground(lipgloss.Color("0")).Background(lipgloss.Color(look.color))
+	if look.flash && frame%2 == 1 {
+		banner = banner.Foreground(lipgloss.Color(look.color)).Background(lipgloss.Color("235"))
+	}
+	anim := lipgloss.NewStyle().Foreground(lipgloss.Color(look.color)).Bold(true).
+		Render(look.frames[frame%len(look.frames)])
+	line := "  " + banner.Render(look.title) + " " + anim
+	if detail != "" {
+		line += " " + lipgloss.NewStyle().Foreground(lipgloss.Color("252")).Render(detail)
+	}
+	return line + "\n"
+}
+
+// alertTickMsg advances a banner's animation; seq ties it to one banner
+type alertTickMsg struct {
+	session int64
+	seq     int
+}
+
+// clearAlertMsg takes a banner down unless a newer one replaced it
+type clearAlertMsg struct {
+	session int64
+	seq     int
+}
+
+func alertTickCmd(session int64, seq int) tea.Cmd {
+	return tea.Tick(150*time.Millisecond, func(time.Time) tea.Msg {
+		return alertTickMsg{session: session, seq: seq}
+	})
+}
+
+func clearAlert(session int64, seq int) tea.Cmd {
+	return tea.Tick(alertFor, func(time.Time) tea.Msg {
+		return clearAlertMsg{session: session, seq: seq}
+	})
+}
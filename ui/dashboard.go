@@ -0,0 +1,451 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Dashboard layout
+const (
+	cardWidth       = 30 // Inner width of a game card
+	highlightFor    = 8 * time.Second
+	miniFieldLength = 24
+)
+
+// Dashboard messages
+type dashboardTickMsg time.Time
+type dashboardDataMsg struct {
+	games     []models.Game
+	summaries map[string]*models.GameSummary
+	err       error
+}
+
+// dashboardClearMsg ends one highlight; seq tells it apart from the timer of
+// an earlier highlight on the same card
+type dashboardClearMsg struct {
+	gameID string
+	seq    int
+}
+
+// closeGameMsg is sent by an embedded Model when the user backs out of it
+type closeGameMsg struct{}
+
+// cardHighlight marks a card that just had a notable play
+type cardHighlight int
+
+const (
+	highlightNone cardHighlight = iota
+	highlightScore
+	highlightTurnover
+)
+
+// DashboardModel tiles every live game as a compact card
+type DashboardModel struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	service    *service.ScoreService
+	plain      bool
+	mascot     bool
+	pollConfig service.PollConfig
+	games      []models.Game
+	summaries  map[string]*models.GameSummary
+	highlights map[string]cardHighlight
+	highlitAt  map[string]int // Sequence number of each card's current highlight
+	selected   int
+	loading    bool
+	err        error
+	width      int
+	height     int
+	child      *Model // Game opened with enter, nil while on the dashboard
+}
+
+// NewDashboardModel creates a multi-game live dashboard
+func NewDashboardModel(svc *service.ScoreService, plain, mascot bool, pollConfig service.PollConfig) DashboardModel {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return DashboardModel{
+		ctx:        ctx,
+		cancel:     cancel,
+		service:    svc,
+		plain:      plain,
+		mascot:     mascot,
+		pollConfig: pollConfig,
+		summaries:  make(map[string]*models.GameSummary),
+		highlights: make(map[string]cardHighlight),
+		highlitAt:  make(map[string]int),
+		loading:    true,
+		width:      80,
+		height:     24,
+	}
+}
+
+// Init starts the first refresh
+func (m DashboardModel) Init() tea.Cmd {
+	return fetchDashboardCmd(m.ctx, m.service)
+}
+
+// Update handles messages
+func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Dashboard refreshes keep running while a game is open
+	switch msg := msg.(type) {
+	case dashboardTickMsg:
+		return m, fetchDashboardCmd(m.ctx, m.service)
+
+	case dashboardDataMsg:
+		return m.applyData(msg)
+
+	case dashboardClearMsg:
+		if msg.seq == m.highlitAt[msg.gameID] {
+			delete(m.highlights, msg.gameID)
+		}
+		return m, nil
+
+	case closeGameMsg:
+		m.child = nil
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+
+	if m.child != nil {
+		child, cmd := m.child.Update(msg)
+		c := child.(Model)
+		m.child = &c
+		return m, cmd
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		cols := m.columns()
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			m.cancel()
+			return m, tea.Quit
+		case "left", "h":
+			if m.selected > 0 {
+				m.selected--
+			}
+		case "right", "l":
+			if m.selected < len(m.games)-1 {
+				m.selected++
+			}
+		case "up", "k":
+			if m.selected-cols >= 0 {
+				m.selected -= cols
+			}
+		case "down", "j":
+			if m.selected+cols < len(m.games) {
+				m.selected += cols
+			}
+		case "enter", " ":
+			if m.selected < len(m.games) {
+				return m.openGame(m.games[m.selected].ID)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// openGame embeds the single-game live view for gameID
+func (m DashboardModel) openGame(gameID string) (tea.Model, tea.Cmd) {
+	child := NewModel(gameID, m.service, m.plain).WithPollConfig(m.pollConfig)
+	child.showMascot = m.mascot
+	child.embedded = true
+	child.width = m.width
+	child.height = m.height
+	m.child = &child
+	return m, child.Init()
+}
+
+func (m DashboardModel) applyData(msg dashboardDataMsg) (tea.Model, tea.Cmd) {
+	m.loading = false
+	next := tea.Tick(m.pollConfig.Interval, func(t time.Time) tea.Msg {
+		return dashboardTickMsg(t)
+	})
+
+	if msg.err != nil {
+		// Keep the last good cards if we have them
+		if len(m.games) == 0 {
+			m.err = msg.err
+		}
+		return m, next
+	}
+	m.err = nil
+
+	cmds := []tea.Cmd{next}
+	for i, g := range msg.games {
+		curr := msg.summaries[g.ID]
+		if curr == nil {
+			continue
+		}
+		// The summary is fetched after the scoreboard, so it has the fresher score
+		msg.games[i].HomeTeam.Score = curr.Game.HomeTeam.Score
+		msg.games[i].AwayTeam.Score = curr.Game.AwayTeam.Score
+		if curr.Game.StatusText != "" {
+			msg.games[i].Status = curr.Game.Status
+			msg.games[i].StatusText = curr.Game.StatusText
+		}
+		prev := m.summaries[g.ID]
+		events := service.DetectEvents(prev, curr, service.DiffSummaries(prev, curr), time.Now())
+		kind := highlightNone
+		switch {
+		case hasEvent(events, service.EventScoringPlay):
+			kind = highlightScore
+		case hasEvent(events, service.EventTurnover):
+			kind = highlightTurnover
+		}
+		if kind != highlightNone {
+			m.highlights[g.ID] = kind
+			m.highlitAt[g.ID]++
+			id, seq := g.ID, m.highlitAt[g.ID]
+			cmds = append(cmds, tea.Tick(highlightFor, func(time.Time) tea.Msg {
+				return dashboardClearMsg{gameID: id, seq: seq}
+			}))
+		}
+		m.summaries[g.ID] = curr
+	}
+
+	m.games = msg.games
+	if m.selected >= len(m.games) {
+		m.selected = max(0, len(m.games)-1)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// View renders the dashboard or the opened game
+func (m DashboardModel) View() string {
+	if m.child != nil {
+		return m.child.View()
+	}
+
+	if m.loading {
+		return "\n\n   Loading live games...\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("\n\n   Error: %v\n\n   Press q to quit.\n", m.err)
+	}
+
+	if len(m.games) == 0 {
+		return "\n\n   No live games right now. The dashboard will refresh automatically.\n\n   Press q to quit.\n"
+	}
+
+	cards := make([]string, len(m.games))
+	for i, g := range m.games {
+		if m.plain {
+			cards[i] = m.renderCardPlain(g, i == m.selected)
+		} else {
+			cards[i] = m.renderCardStyled(g, i == m.selected)
+		}
+	}
+
+	var rows []string
+	cols := m.columns()
+	for i := 0; i < len(cards); i += cols {
+		end := min(i+cols, len(cards))
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cards[i:end]...))
+	}
+
+	var sb strings.Builder
+	title := fmt.Sprintf("  LIVE DASHBOARD  (%d games)", len(m.games))
+	help := "  ←↑↓→: select • Enter: open game • Esc/q: quit"
+	if m.plain {
+		sb.WriteString("\n" + title + "\n\n")
+		sb.WriteString(strings.Join(rows, "\n") + "\n")
+		sb.WriteString("\n" + help + "\n")
+		return sb.String()
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("39")).
+		Background(lipgloss.Color("235")).
+		Padding(0, 2)
+	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	sb.WriteString(titleStyle.Render(strings.TrimSpace(title)) + "\n")
+	sb.WriteString(strings.Join(rows, "\n") + "\n")
+	sb.WriteString(helpStyle.Render(help) + "\n")
+	return sb.String()
+}
+
+// columns returns how many cards fit across the terminal
+func (m DashboardModel) columns() int {
+	return max(1, m.width/(cardWidth+4))
+}
+
+// cardLines returns the text content of a card shared by both render modes
+func (m DashboardModel) cardLines(g models.Game) []string {
+	lines := []string{
+		fmt.Sprintf("%-4s %3d", g.AwayTeam.Abbreviation, g.AwayTeam.Score),
+		fmt.Sprintf("%-4s %3d", g.HomeTeam.Abbreviation, g.HomeTeam.Score),
+	}
+
+	summary := m.summaries[g.ID]
+	situation := ""
+	possession := ""
+	yardsToEndzone := 50
+	if summary != nil {
+		situation = summary.Situation
+		if summary.YardsToEndzone != 0 {
+			yardsToEndzone = summary.YardsToEndzone
+		}
+		if summary.CurrentPlay != nil {
+			possession = summary.CurrentPlay.Possession
+		}
+	}
+
+	lines = append(lines, truncateCard(situation))
+	lines = append(lines, RenderMiniField(yardsToEndzone, miniFieldLength, m.plain))
+	if possession != "" {
+		lines = append(lines, possession+" ball")
+	} else {
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+func (m DashboardModel) renderCardPlain(g models.Game, selected bool) string {
+	lines := m.cardLines(g)
+	status := truncateCard(g.StatusText)
+
+	switch m.highlights[g.ID] {
+	case highlightScore:
+		lines[4] = padCard(lines[4], "SCORE!")
+	case highlightTurnover:
+		lines[4] = padCard(lines[4], "TURNOVER")
+	}
+
+	edge := "+" + strings.Repeat("-", cardWidth+2) + "+"
+	if selected {
+		edge = "#" + strings.Repeat("=", cardWidth+2) + "#"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(edge + "\n")
+	sb.WriteString(fmt.Sprintf("| %-*s |\n", cardWidth, padCard(lines[0], status)))
+	for _, l := range lines[1:] {
+		sb.WriteString(fmt.Sprintf("| %-*s |\n", cardWidth, l))
+	}
+	sb.WriteString(edge)
+	return sb.String()
+}
+
+func (m DashboardModel) renderCardStyled(g models.Game, selected bool) string {
+	lines := m.cardLines(g)
+
+	teamStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("255"))
+	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)
+	situationStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
+	borderColor := lipgloss.Color("238")
+	if selected {
+		borderColor = lipgloss.Color("39")
+	}
+	badge := ""
+	switch m.highlights[g.ID] {
+	case highlightScore:
+		borderColor = lipgloss.Color("226")
+		badge = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("226")).Render(" SCORE! ")
+	case highlightTurnover:
+		borderColor = lipgloss.Color("196")
+		badge = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("255")).Background(lipgloss.Color("196")).Render(" TURNOVER ")
+	}
+
+	status := statusStyle.Render("● " + truncateCard(g.StatusText))
+	header := teamStyle.Render(lines[0]) + strings.Repeat(" ", max(1, cardWidth-len(lines[0])-lipgloss.Width(status))) + status
+
+	body := []string{
+		header,
+		teamStyle.Render(lines[1]),
+		situationStyle.Render(lines[2]),
+		lines[3],
+		dimStyle.Render(lines[4]) + strings.Repeat(" ", max(1, cardWidth-len(lines[4])-lipgloss.Width(badge))) + badge,
+	}
+
+	cardStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Width(cardWidth + 2)
+
+	return cardStyle.Render(strings.Join(body, "\n"))
+}
+
+// RenderMiniField draws a one-line field with the ball position
+func RenderMiniField(yardsToEndzone, length int, plain bool) string {
+	pos := int(float64(100-yardsToEndzone) / 100.0 * float64(length-1))
+	pos = max(0, min(pos, length-1))
+
+	if plain {
+		return "|" + strings.Repeat(".", pos) + "o" + strings.Repeat(".", length-1-pos) + "|"
+	}
+
+	fieldStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("34"))
+	ballStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Bold(true)
+	return fieldStyle.Render("▕"+strings.Repeat("░", pos)) +
+		ballStyle.Render("●") +
+		fieldStyle.Render(strings.Repeat("░", length-1-pos)+"▏")
+}
+
+func truncateCard(s string) string {
+	if len(s) <= cardWidth {
+		return s
+	}
+	return s[:cardWidth-1] + "…"
+}
+
+// padCard right-aligns suffix after s within the card width
+func padCard(s, suffix string) string {
+	gap := cardWidth - len(s) - len(suffix)
+	if gap < 1 {
+		return s
+	}
+	return s + strings.Repeat(" ", gap) + suffix
+}
+
+// fetchDashboardCmd loads live games and their summaries concurrently
+func fetchDashboardCmd(ctx context.Context, svc *service.ScoreService) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(service.Polling(ctx), fetchTimeout)
+		defer cancel()
+
+		games, err := svc.GetLiveGames(ctx)
+		if err != nil {
+			return dashboardDataMsg{err: err}
+		}
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		summaries := make(map[string]*models.GameSummary, len(games))
+		for _, g := range games {
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				summary, err := svc.GetGameSummary(ctx, id)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				summaries[id] = summary
+				mu.Unlock()
+			}(g.ID)
+		}
+		wg.Wait()
+
+		return dashboardDataMsg{games: games, summaries: summaries}
+	}
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"testing"
+
+	"nfl-scores/fakeespn"
+	"nfl-scores/service"
+)
+
+func TestDashboardHighlightsScores(t *testing.T) {
+	m := NewDashboardModel(fakeScoreService(t, 1), true, false, service.DefaultPollConfig())
+	id := fakeespn.DefaultLiveGameID
+
+	for i := 0; m.highlights[id] != highlightScore; i++ {
+		if i > 200 {
+			t.Fatal("no score highlight over the whole game")
+		}
+		next, _ := m.Update(fetchDashboardCmd(m.ctx, m.service)())
+		m = next.(DashboardModel)
+	}
+	if m.highlitAt[id] == 0 {
+		t.Fatal("highlight has no sequence number")
+	}
+}
+
+func TestDashboardIgnoresStaleClear(t *testing.T) {
+	m := NewDashboardModel(nil, true, false, service.DefaultPollConfig())
+	m.highlights["1"], m.highlitAt["1"] = highlightTurnover, 2
+
+	// The timer from the card's first highlight fires during the second
+	next, _ := m.Update(dashboardClearMsg{gameID: "1", seq: 1})
+	m = next.(DashboardModel)
+	if m.highlights["1"] != highlightTurnover {
+		t.Fatal("an earlier highlight's timer cleared the newer one")
+	}
+
+	next, _ = m.Update(dashboardClearMsg{gameID: "1", seq: 2})
+	m = next.(DashboardModel)
+	if _, ok := m.highlights["1"]; ok {
+		t.Error("the current highlight's timer didn't clear it")
+	}
+}
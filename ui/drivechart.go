@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"nfl-scores/models"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// driveFieldCols is the width of the drive chart's field, two yards a column
+const driveFieldCols = 50
+
+// driveChartRows is how many drives the chart shows at once
+const driveChartRows = 12
+
+// driveIndex is the drive holding the play at index, or -1
+func driveIndex(r *models.GameReplay, index int) int {
+	for i, d := range r.Drives {
+		if d.Contains(index) {
+			return i
+		}
+	}
+	return -1
+}
+
+// renderDriveChart draws each drive as a bar across the field from its
+// start to its end yard line, in the offense's color, with how it ended.
+// The home goal line is on the left. current marks the drive holding the
+// play on screen (►) and selected the one enter jumps to (>); the rows
+// scroll to keep the selection in view.
+func renderDriveChart(r *models.GameReplay, current, selected int, plain bool) string {
+	g := r.Game
+	first := max(0, min(selected-driveChartRows/2, len(r.Drives)-driveChartRows))
+	last := min(len(r.Drives), first+driveChartRows)
+
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	var sb strings.Builder
+	title := fmt.Sprintf("DRIVES  %d-%d of %d", first+1, last, len(r.Drives))
+	ruler := fmt.Sprintf("%12s %s%s", g.HomeTeam.Abbreviation, driveRuler(), g.AwayTeam.Abbreviation)
+	if plain {
+		sb.WriteString("  " + title + "\n")
+		sb.WriteString(ruler + "\n")
+	} else {
+		sb.WriteString("  " + lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Render(title) + "\n")
+		sb.WriteString(dim.Render(ruler) + "\n")
+	}
+
+	for i := first; i < last; i++ {
+		d := r.Drives[i]
+		period := ""
+		if d.StartIndex >= 0 && d.StartIndex < len(r.Plays) && d.StartIndex <= d.EndIndex {
+			period = fmt.Sprintf("Q%d", r.Plays[d.StartIndex].Period)
+		}
+
+		pick, here := " ", " "
+		if i == selected {
+			pick = ">"
+		}
+		if i == current {
+			here = "►"
+			if plain {
+				here = "*"
+			}
+		}
+
+		label := d.ResultLabel()
+		if plain {
+			fmt.Fprintf(&sb, "  %s%s %-3s %-4s|%s| %s\n", pick, here, period, d.Team, driveBar(d, true), label)
+			continue
+		}
+
+		color := lipgloss.Color("255")
+		if c, ok := teamColors[d.Team]; ok {
+			color = lipgloss.Color(c)
+		}
+		labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+		switch {
+		case d.IsScore:
+			labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("40")).Bold(true)
+		case d.Turnover():
+			labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)
+		}
+
+		bar := colorRuns(driveBar(d, false), lipgloss.NewStyle().Foreground(color).Bold(true), dim)
+		periodStyle := dim
+		if i == selected {
+			periodStyle = lipgloss.NewStyle().Reverse(true)
+		}
+		fmt.Fprintf(&sb, "  %s%s %s %s│%s│ %s\n",
+			lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Bold(true).Render(pick),
+			lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Render(here),
+			periodStyle.Render(fmt.Sprintf("%-3s", period)),
+			lipgloss.NewStyle().Foreground(color).Bold(true).Render(fmt.Sprintf("%-4s", d.Team)),
+			bar,
+			labelStyle.Render(label))
+	}
+	return sb.String()
+}
+
+// driveBar is one drive's strip of the field: dots for open field and a
+// bar from the start to the end yard line, pointing the way it went
+func driveBar(d models.ReplayDrive, plain bool) string {
+	open, fill, right, left := '·', '━', '▶', '◀'
+	if plain {
+		open, fill, right, left = '.', '=', '>', '<'
+	}
+
+	col := func(yardLine int) int {
+		return max(0, min(yardLine*driveFieldCols/100, driveFieldCols-1))
+	}
+	start, end := col(d.StartYardLine), col(d.EndYardLine)
+	lo, hi := min(start, end), max(start, end)
+
+	cells := make([]rune, driveFieldCols)
+	for i := range cells {
+		switch {
+		case i == end && end > start:
+			cells[i] = right
+		case i == end && end < start:
+			cells[i] = left
+		case i >= lo && i <= hi:
+			cells[i] = fill
+		default:
+			cells[i] = open
+		}
+	}
+	return string(cells)
+}
+
+// colorRuns styles a drive strip: the bar in the team's color and the open
+// field dimmed
+func colorRuns(bar string, barStyle, openStyle lipgloss.Style) string {
+	var sb, run strings.Builder
+	inBar := false
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+		style := openStyle
+		if inBar {
+			style = barStyle
+		}
+		sb.WriteString(style.Render(run.String()))
+		run.Reset()
+	}
+	for _, r := range bar {
+		if isBar := r != '·'; isBar != inBar {
+			flush()
+			inBar = isBar
+		}
+		run.WriteRune(r)
+	}
+	flush()
+	return sb.String()
+}
+
+// driveRuler labels the drive chart's yard lines every ten yards
+func driveRuler() string {
+	cells := []rune(strings.Repeat(" ", driveFieldCols+2))
+	for yard := 10; yard < 100; yard += 10 {
+		label := fmt.Sprintf("%d", min(yard, 100-yard))
+		col := 1 + yard*driveFieldCols/100 - 1
+		copy(cells[col:], []rune(label))
+	}
+	return string(cells)
+}
@@ -1,8 +1,10 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"nfl-scores/models"
@@ -13,35 +15,63 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Messages
-type tickMsg time.Time
-type gameDataMsg *models.GameSummary
-type errorMsg error
-type mascotTickMsg time.Time
+// fetchTimeout bounds a single refresh, including client retries
+const fetchTimeout = 30 * time.Second
+
+// Messages carry the session of the Model that issued them, so a game
+// reopened from the dashboard or picker ignores stragglers from the previous one
+type tickMsg struct{ session int64 }
+type gameDataMsg struct {
+	session int64
+	summary *models.GameSummary
+}
+type errorMsg struct {
+	session int64
+	err     error
+}
+
+// sessions numbers each Model instance
+var sessions atomic.Int64
+
+type mascotTickMsg struct{ session int64 }
 
 // Model holds the UI state
 type Model struct {
-	gameID         string
-	service        *service.ScoreService
-	summary        *models.GameSummary
-	prevSummary    *models.GameSummary
-	spinner        spinner.Model
-	loading        bool
-	err            error
-	plain          bool
-	lastPlayID     string
-	flashScore     bool
-	flashPlay      bool
-	width          int
-	height         int
-	selectedPlay   int // -1 means no play selected, >= 0 is index
-	expandedPlay   int // -1 means no play expanded
-	playsStartY    int // Y coordinate where plays list starts
-	showMascot     bool
-	mascotFrame    int
-	mascotState    MascotState
-	lastPossession string
-	showFireworks  bool
+	session       int64
+	ctx           context.Context
+	cancel        context.CancelFunc
+	gameID        string
+	service       *service.ScoreService
+	summary       *models.GameSummary
+	prevSummary   *models.GameSummary
+	spinner       spinner.Model
+	loading       bool
+	err           error
+	reconnecting  bool  // Last refresh failed; still showing the last good summary
+	lastErr       error // Most recent refresh error while reconnecting
+	plain         bool
+	flashScore    bool
+	flashPlay     bool
+	scoreSeq      int // Numbers effects so a stale clear leaves a newer one up
+	sadSeq        int
+	playSeq       int
+	width         int
+	height        int
+	selectedPlay  int // -1 means no play selected, >= 0 is index
+	expandedPlay  int // -1 means no play expanded
+	playsStartY   int // Y coordinate where plays list starts
+	showMascot    bool
+	mascotFrame   int
+	mascotState   MascotState
+	showFireworks bool
+	alert         service.PlayKind // Banner for a notable play; routine when none
+	alertPlay     *models.Play
+	alertSeq      int // Numbers banners so a stale clear leaves a newer one up
+	alertFrame    int
+	showLog       bool // Full play-by-play log in place of the field
+	log           playLog
+	poll          *service.PollScheduler
+	embedded      bool // Opened from the dashboard or picker; q/esc returns there instead of quitting
 }
 
 // NewModel creates a new live game UI model
@@ -50,7 +80,12 @@ func NewModel(gameID string, svc *service.ScoreService, plain bool) Model {
 	s.Spinner = spinner.Dot
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 
+	ctx, cancel := context.WithCancel(context.Background())
+
 	return Model{
+		session:      sessions.Add(1),
+		ctx:          ctx,
+		cancel:       cancel,
 		gameID:       gameID,
 		service:      svc,
 		spinner:      s,
@@ -61,6 +96,8 @@ func NewModel(gameID string, svc *service.ScoreService, plain bool) Model {
 		selectedPlay: -1,
 		expandedPlay: -1,
 		showMascot:   false,
+		log:          newPlayLog(plain),
+		poll:         service.NewPollScheduler(service.DefaultPollConfig()),
 	}
 }
 
@@ -71,15 +108,20 @@ func NewModelWithMascot(gameID string, svc *service.ScoreService, plain bool) Mo
 	return m
 }
 
+// WithPollConfig overrides the adaptive polling bounds
+func (m Model) WithPollConfig(cfg service.PollConfig) Model {
+	m.poll = service.NewPollScheduler(cfg)
+	return m
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
 	cmds := []tea.Cmd{
 		m.spinner.Tick,
-		fetchGameDataCmd(m.gameID, m.service),
-		tickCmd(),
+		fetchGameDataCmd(m.ctx, m.session, m.gameID, m.service),
 	}
 	if m.showMascot {
-		cmds = append(cmds, mascotTickCmd())
+		cmds = append(cmds, mascotTickCmd(m.session))
 	}
 	return tea.Batch(cmds...)
 }
@@ -88,13 +130,36 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.showLog && msg.String() != "ctrl+c" {
+			switch {
+			case m.log.searching:
+			case msg.String() == "l", msg.String() == "esc":
+				m.showLog = false
+				return m, nil
+			case msg.String() == "q":
+				m.cancel()
+				if m.embedded {
+					return m, func() tea.Msg { return closeGameMsg{} }
+				}
+				return m, tea.Quit
+			}
+			return m, m.log.update(msg, m.summary)
+		}
 		switch msg.String() {
+		case "l":
+			m.showLog = true
+			m.log.setSize(m.width, m.height)
+			m.log.setSummary(m.summary)
 		case "q", "ctrl+c", "esc":
 			if m.expandedPlay >= 0 {
 				// Close expanded play first
 				m.expandedPlay = -1
 				return m, nil
 			}
+			m.cancel()
+			if m.embedded && msg.String() != "ctrl+c" {
+				return m, func() tea.Msg { return closeGameMsg{} }
+			}
 			return m, tea.Quit
 		case "up", "k":
 			if m.summary != nil && len(m.summary.RecentPlays) > 0 {
@@ -123,6 +188,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.MouseMsg:
+		if m.showLog {
+			return m, m.log.update(msg, m.summary)
+		}
 		if msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft {
 			// Plays start after: header(4) + field(9) + situation(3) + plays header(2) = ~18 rows
 			// But this varies, so we use a range check
@@ -142,6 +210,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.log.setSize(m.width, m.height)
+		m.log.setSummary(m.summary)
 
 	case spinner.TickMsg:
 		var cmd tea.Cmd
@@ -149,66 +219,121 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case tickMsg:
-		return m, tea.Batch(fetchGameDataCmd(m.gameID, m.service), tickCmd())
+		if msg.session != m.session {
+			return m, nil
+		}
+		// The next tick is scheduled once this fetch lands, so polls never overlap
+		return m, fetchGameDataCmd(m.ctx, m.session, m.gameID, m.service)
 
 	case mascotTickMsg:
+		if msg.session != m.session {
+			return m, nil
+		}
 		m.mascotFrame++
-		return m, mascotTickCmd()
+		return m, mascotTickCmd(m.session)
 
 	case gameDataMsg:
+		if msg.session != m.session {
+			return m, nil
+		}
 		m.loading = false
+		m.err = nil
+		m.reconnecting = false
+		m.lastErr = nil
 		m.prevSummary = m.summary
-		m.summary = msg
-
-		// Track current play ID and possession changes
-		if m.summary != nil && m.summary.CurrentPlay != nil {
-			currentPossession := m.summary.CurrentPlay.Possession
-
-			// Check for changes to trigger animations
-			if m.prevSummary != nil {
-				// Score changed - celebrate with fireworks!
-				if m.summary.Game.HomeTeam.Score != m.prevSummary.Game.HomeTeam.Score ||
-					m.summary.Game.AwayTeam.Score != m.prevSummary.Game.AwayTeam.Score {
-					m.flashScore = true
-					m.showFireworks = true
-					m.mascotState = MascotCelebrating
-					return m, clearCelebration()
-				}
+		m.summary = msg.summary
+		if m.showLog {
+			m.log.setSummary(m.summary)
+		}
 
-				// Turnover detection - possession changed without a score
-				if m.lastPossession != "" && currentPossession != m.lastPossession {
-					m.mascotState = MascotSad
-					return m, clearSadMascot()
-				}
+		// React to what changed since the last snapshot
+		now := time.Now()
+		delta := service.DiffSummaries(m.prevSummary, m.summary)
+		events := service.DetectEvents(m.prevSummary, m.summary, delta, now)
+		next := tickCmd(m.session, m.poll.Next(m.summary, delta, now))
+		cmds := []tea.Cmd{next}
+		kind, play := strongestPlay(events)
+		if kind != service.PlayRoutine {
+			// Banner for the play; a score still gets its fireworks below
+			m.alert, m.alertPlay, m.alertFrame = kind, play, 0
+			m.alertSeq++
+			cmds = append(cmds, alertTickCmd(m.session, m.alertSeq), clearAlert(m.session, m.alertSeq))
+		}
+		switch {
+		case hasEvent(events, service.EventScoringPlay):
+			// Score changed - celebrate with fireworks!
+			m.flashScore = true
+			m.showFireworks = true
+			m.mascotState = MascotCelebrating
+			m.scoreSeq++
+			cmds = append(cmds, clearCelebration(m.session, m.scoreSeq))
+
+		case hasEvent(events, service.EventTurnover):
+			// The offense gave the ball away; punts and kickoffs aren't sad
+			m.mascotState = MascotSad
+			m.sadSeq++
+			cmds = append(cmds, clearSadMascot(m.session, m.sadSeq))
+
+		case kind != service.PlayRoutine:
+			// The banner is reaction enough
+
+		case hasEvent(events, service.EventNewPlay):
+			// New play
+			m.flashPlay = true
+			m.playSeq++
+			cmds = append(cmds, clearFlashPlay(m.session, m.playSeq))
+		}
+		return m, tea.Batch(cmds...)
 
-				// New play
-				if m.summary.CurrentPlay.ID != m.lastPlayID {
-					m.flashPlay = true
-					m.lastPlayID = m.summary.CurrentPlay.ID
-					return m, clearFlashPlay()
-				}
-			}
-			m.lastPlayID = m.summary.CurrentPlay.ID
-			m.lastPossession = currentPossession
+	case alertTickMsg:
+		if msg.session != m.session || msg.seq != m.alertSeq || m.alert == service.PlayRoutine {
+			return m, nil
+		}
+		m.alertFrame++
+		return m, alertTickCmd(m.session, m.alertSeq)
+
+	case clearAlertMsg:
+		if msg.session == m.session && msg.seq == m.alertSeq {
+			m.alert, m.alertPlay = service.PlayRoutine, nil
 		}
 
 	case clearCelebrationMsg:
-		m.flashScore = false
-		m.showFireworks = false
-		m.mascotState = MascotNormal
+		if msg.session == m.session && msg.seq == m.scoreSeq {
+			m.flashScore = false
+			m.showFireworks = false
+			if m.mascotState == MascotCelebrating {
+				m.mascotState = MascotNormal
+			}
+		}
 
 	case clearSadMascotMsg:
-		m.mascotState = MascotNormal
+		if msg.session == m.session && msg.seq == m.sadSeq && m.mascotState == MascotSad {
+			m.mascotState = MascotNormal
+		}
 
 	case clearFlashScoreMsg:
-		m.flashScore = false
+		if msg.session == m.session && msg.seq == m.scoreSeq {
+			m.flashScore = false
+		}
 
 	case clearFlashPlayMsg:
-		m.flashPlay = false
+		if msg.session == m.session && msg.seq == m.playSeq {
+			m.flashPlay = false
+		}
 
 	case errorMsg:
-		m.err = msg
+		if msg.session != m.session {
+			return m, nil
+		}
 		m.loading = false
+		// Keep showing the last good summary; the next tick retries
+		if m.summary != nil {
+			m.reconnecting = true
+			m.lastErr = msg.err
+		} else {
+			m.err = msg.err
+		}
+		return m, tickCmd(m.session, m.poll.Retry())
 	}
 
 	return m, nil
@@ -233,12 +358,43 @@ func (m Model) View() string {
 		return m.renderVictory()
 	}
 
+	if m.showLog {
+		return m.renderLog()
+	}
 	if m.plain {
 		return m.renderPlain()
 	}
 	return m.renderStyled()
 }
 
+// renderLog shows the score above the full play-by-play log
+func (m Model) renderLog() string {
+	var sb strings.Builder
+	g := m.summary.Game
+	score := fmt.Sprintf("  %s %d  @  %s %d   %s", g.AwayTeam.Abbreviation, g.AwayTeam.Score,
+		g.HomeTeam.Abbreviation, g.HomeTeam.Score, g.StatusText)
+	title := fmt.Sprintf("  PLAY-BY-PLAY  %d plays", len(m.summary.Plays))
+	help := m.log.help()
+	if m.plain {
+		sb.WriteString(strings.Repeat("=", 70) + "\n" + score + "\n" + strings.Repeat("=", 70) + "\n")
+	} else {
+		border := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(strings.Repeat("━", 62))
+		sb.WriteString(border + "\n" + lipgloss.NewStyle().Bold(true).Render(score) + "\n" + border + "\n")
+		title = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Render(title)
+		help = lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(help)
+	}
+	// The banner row is kept even when empty so the log doesn't jump
+	alert := renderAlert(m.alert, m.alertPlay, m.alertFrame, m.plain)
+	if alert == "" {
+		alert = "\n"
+	}
+	sb.WriteString(alert)
+	sb.WriteString(title + "\n")
+	sb.WriteString(m.log.viewport.View() + "\n")
+	sb.WriteString(help + "\n")
+	return sb.String()
+}
+
 func (m Model) renderVictory() string {
 	g := m.summary.Game
 
@@ -271,23 +427,27 @@ func (m Model) renderPlain() string {
 		g.AwayTeam.Abbreviation, g.AwayTeam.Score,
 		g.HomeTeam.Abbreviation, g.HomeTeam.Score,
 		g.StatusText))
-	sb.WriteString(strings.Repeat("=", 70) + "\n\n")
+	sb.WriteString(strings.Repeat("=", 70) + "\n")
+	if m.reconnecting {
+		sb.WriteString("  RECONNECTING... showing last update\n")
+	}
+	sb.WriteString(renderAlert(m.alert, m.alertPlay, m.alertFrame, true))
+	sb.WriteString("\n")
 
 	// Field - use actual yards to endzone
 	yardsToEndzone := m.summary.YardsToEndzone
 	if yardsToEndzone == 0 {
 		yardsToEndzone = 50
 	}
-	possession := ""
-	if m.summary.CurrentPlay != nil {
-		possession = m.summary.CurrentPlay.Possession
-	}
-	sb.WriteString(RenderField(yardsToEndzone, possession, true))
+	sb.WriteString(RenderField(yardsToEndzone, m.ballTeam(), true))
 
 	// Situation
 	if m.summary.Situation != "" {
 		sb.WriteString(fmt.Sprintf("\n  SITUATION: %s\n", m.summary.Situation))
 	}
+	if wp := m.winProbability(); wp != "" {
+		sb.WriteString(fmt.Sprintf("  WIN PROBABILITY: %s\n", wp))
+	}
 
 	// Recent plays
 	sb.WriteString("\n  RECENT PLAYS:\n")
@@ -300,7 +460,7 @@ func (m Model) renderPlain() string {
 		sb.WriteString(fmt.Sprintf("  Q%d %5s │ %s\n", play.Period, play.Clock, text))
 	}
 
-	sb.WriteString("\n  Press q to quit\n")
+	sb.WriteString("\n  Press l for the full play log, q to quit\n")
 	return sb.String()
 }
 
@@ -366,10 +526,12 @@ func (m Model) renderStyled() string {
 	homeScore := scoreStyle.Render(fmt.Sprintf("%d", g.HomeTeam.Score))
 
 	liveIndicator := ""
-	switch g.Status {
-	case models.StatusInProgress:
+	switch {
+	case m.reconnecting:
+		liveIndicator = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true).Render(" ⟳ RECONNECTING")
+	case g.Status == models.StatusInProgress:
 		liveIndicator = liveStyle.Render(" ● LIVE")
-	case models.StatusFinal:
+	case g.Status == models.StatusFinal:
 		liveIndicator = lipgloss.NewStyle().Foreground(lipgloss.Color("40")).Render(" ✓ FINAL")
 	}
 
@@ -386,6 +548,7 @@ func (m Model) renderStyled() string {
 	sb.WriteString(border + "\n")
 	sb.WriteString(scoreLine + "\n")
 	sb.WriteString(border + "\n")
+	sb.WriteString(renderAlert(m.alert, m.alertPlay, m.alertFrame, false))
 
 	// Show fireworks above field when celebrating
 	if m.showMascot && m.showFireworks {
@@ -400,26 +563,32 @@ func (m Model) renderStyled() string {
 	if yardsToEndzone == 0 {
 		yardsToEndzone = 50 // Default to midfield if unknown
 	}
-	possession := ""
-	if m.summary.CurrentPlay != nil {
-		possession = m.summary.CurrentPlay.Possession
-	}
+	possession := m.ballTeam()
 	sb.WriteString("\n")
 
 	// Render field with optional mascot
 	fieldStr := RenderField(yardsToEndzone, possession, false)
 	if m.showMascot && possession != "" {
-		mascotStr := RenderMascotWithState(possession, m.mascotFrame, m.mascotState, false)
+		mascot := possession
+		if m.mascotState == MascotSad {
+			// Sad for the team that just gave the ball away
+			mascot = m.summary.CurrentPlay.Possession
+		}
+		mascotStr := RenderMascotWithState(mascot, m.mascotFrame, m.mascotState, false)
 		// Join field and mascot side by side
 		sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, fieldStr, "  ", mascotStr))
 	} else {
 		sb.WriteString(fieldStr)
 	}
 
-	// Current situation
+	// Current situation, with the win probability beside it
 	if m.summary.Situation != "" {
+		situation := "  " + situationStyle.Render(m.summary.Situation)
+		if wp := m.winProbability(); wp != "" {
+			situation += statusStyle.Render("   Win: ") + wp
+		}
 		sb.WriteString("\n  " + headerStyle.Render("󰈍 SITUATION") + "\n")
-		sb.WriteString("  " + situationStyle.Render(m.summary.Situation) + "\n")
+		sb.WriteString(situation + "\n")
 	}
 
 	// Recent plays
@@ -472,59 +641,109 @@ func (m Model) renderStyled() string {
 	}
 
 	sb.WriteString("\n" + border + "\n")
-	sb.WriteString(statusStyle.Render("  Press q to quit • Auto-refreshing every 10s") + "\n")
+	sb.WriteString(statusStyle.Render(fmt.Sprintf("  l: full play log • q: quit • Auto-refreshing every %s", m.poll.Current())) + "\n")
 
 	return sb.String()
 }
 
+// ballTeam is who has the ball after the latest play: the defense after an
+// interception or lost fumble, though ESPN still files the play under the
+// offense's drive
+func (m Model) ballTeam() string {
+	p := m.summary.CurrentPlay
+	switch {
+	case p == nil:
+		return ""
+	case p.EndPossession != "":
+		return p.EndPossession
+	}
+	return p.Possession
+}
+
+// winProbability is each team's chance to win after the latest play, or ""
+func (m Model) winProbability() string {
+	if m.summary.CurrentPlay == nil {
+		return ""
+	}
+	return winProbabilityText(m.summary.Game, m.summary.CurrentPlay.WinProbability)
+}
+
+// hasEvent reports whether events includes one of type t
+func hasEvent(events []service.Event, t service.EventType) bool {
+	for _, e := range events {
+		if e.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
 // Commands
-func fetchGameDataCmd(gameID string, svc *service.ScoreService) tea.Cmd {
+func fetchGameDataCmd(ctx context.Context, session int64, gameID string, svc *service.ScoreService) tea.Cmd {
 	return func() tea.Msg {
-		summary, err := svc.GetGameSummary(gameID)
+		ctx, cancel := context.WithTimeout(service.Polling(ctx), fetchTimeout)
+		defer cancel()
+
+		summary, err := svc.GetGameSummary(ctx, gameID)
 		if err != nil {
-			return errorMsg(err)
+			return errorMsg{session: session, err: err}
 		}
-		return gameDataMsg(summary)
+		return gameDataMsg{session: session, summary: summary}
 	}
 }
 
-func tickCmd() tea.Cmd {
-	return tea.Tick(10*time.Second, func(t time.Time) tea.Msg {
-		return tickMsg(t)
+func tickCmd(session int64, d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(t time.Time) tea.Msg {
+		return tickMsg{session: session}
 	})
 }
 
-type clearFlashScoreMsg struct{}
-type clearFlashPlayMsg struct{}
-type clearCelebrationMsg struct{}
-type clearSadMascotMsg struct{}
+// Effect timers carry their session and the sequence number of the effect
+// they end, so a timer from a game the user has left, or from an earlier
+// play, can't cut a newer effect short
+type clearFlashScoreMsg struct {
+	session int64
+	seq     int
+}
+type clearFlashPlayMsg struct {
+	session int64
+	seq     int
+}
+type clearCelebrationMsg struct {
+	session int64
+	seq     int
+}
+type clearSadMascotMsg struct {
+	session int64
+	seq     int
+}
 
-func clearFlashScore() tea.Cmd {
+func clearFlashScore(session int64, seq int) tea.Cmd {
 	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
-		return clearFlashScoreMsg{}
+		return clearFlashScoreMsg{session: session, seq: seq}
 	})
 }
 
-func clearFlashPlay() tea.Cmd {
+func clearFlashPlay(session int64, seq int) tea.Cmd {
 	return tea.Tick(1*time.Second, func(t time.Time) tea.Msg {
-		return clearFlashPlayMsg{}
+		return clearFlashPlayMsg{session: session, seq: seq}
 	})
 }
 
-func clearCelebration() tea.Cmd {
+func clearCelebration(session int64, seq int) tea.Cmd {
 	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
-		return clearCelebrationMsg{}
+		return clearCelebrationMsg{session: session, seq: seq}
 	})
 }
 
-func clearSadMascot() tea.Cmd {
+func clearSadMascot(session int64, seq int) tea.Cmd {
 	return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
-		return clearSadMascotMsg{}
+		return clearSadMascotMsg{session: session, seq: seq}
 	})
 }
 
-func mascotTickCmd() tea.Cmd {
-	return tea.Tick(300*time.Millisecond, func(t time.Time) tea.Msg {
-		return mascotTickMsg(t)
+func mascotTickCmd(session int64) tea.Cmd {
+	return tea.Tick(300*time.Millisecond, func(time.Time) tea.Msg {
+		return mascotTickMsg{session: session}
 	})
 }
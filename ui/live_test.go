@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fakeScoreService serves the bundled game as live, playsPerPoll at a time
+func fakeScoreService(t *testing.T, playsPerPoll int) *service.ScoreService {
+	t.Helper()
+	srv, err := fakeespn.Start(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, playsPerPoll))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	return service.NewScoreService(client.NewESPNClientWithBaseURL(srv.URL()))
+}
+
+// poll runs one fetch for m the way its tick would and feeds the result back
+func poll(t *testing.T, m Model) Model {
+	t.Helper()
+	msg := fetchGameDataCmd(m.ctx, m.session, m.gameID, m.service)()
+	if e, ok := msg.(errorMsg); ok {
+		t.Fatalf("fetch failed: %v", e.err)
+	}
+	next, _ := m.Update(msg)
+	return next.(Model)
+}
+
+func TestLiveModelReactsToPlays(t *testing.T) {
+	m := NewModel(fakeespn.DefaultLiveGameID, fakeScoreService(t, 1), true)
+
+	var celebrated, sad, flashed, alerted bool
+	for i := 0; ; i++ {
+		before := m.summary
+		m = poll(t, m)
+		if m.loading || m.err != nil {
+			t.Fatalf("poll %d: loading=%v err=%v", i, m.loading, m.err)
+		}
+		if before == nil {
+			if m.showFireworks || m.mascotState != MascotNormal || m.flashPlay {
+				t.Fatal("first snapshot triggered effects")
+			}
+			continue
+		}
+
+		play := m.summary.Plays[len(m.summary.Plays)-1]
+		kind := service.ClassifyPlay(play)
+		switch {
+		case play.ScoringPlay:
+			if !m.showFireworks || m.mascotState != MascotCelebrating {
+				t.Errorf("scoring play %q didn't celebrate", play.Text)
+			}
+			celebrated = true
+		case kind.IsTurnover():
+			if m.mascotState != MascotSad {
+				t.Errorf("turnover %q didn't sadden the mascot", play.Text)
+			}
+			sad = true
+		}
+		if kind != service.PlayRoutine {
+			if m.alert != kind {
+				t.Errorf("play %q: banner %q, want %q", play.Text, m.alert, kind)
+			}
+			alerted = true
+		}
+		if kind == service.PlayRoutine && !play.ScoringPlay && m.flashPlay {
+			flashed = true
+		}
+
+		// Reset the effects as their timers would
+		for _, msg := range []tea.Msg{
+			clearCelebrationMsg{session: m.session, seq: m.scoreSeq},
+			clearSadMascotMsg{session: m.session, seq: m.sadSeq},
+			clearFlashPlayMsg{session: m.session, seq: m.playSeq},
+			clearAlertMsg{session: m.session, seq: m.alertSeq},
+		} {
+			next, _ := m.Update(msg)
+			m = next.(Model)
+		}
+
+		if m.summary.Game.Status == models.StatusFinal {
+			break
+		}
+		if i > 200 {
+			t.Fatal("game never went final")
+		}
+	}
+
+	if !celebrated || !sad || !flashed || !alerted {
+		t.Errorf("celebrated=%v sad=%v flashed=%v alerted=%v, want all seen over a full game", celebrated, sad, flashed, alerted)
+	}
+}
+
+func TestLiveModelIgnoresOtherSessions(t *testing.T) {
+	svc := fakeScoreService(t, 1)
+	m := poll(t, NewModel(fakeespn.DefaultLiveGameID, svc, true))
+	other := NewModel(fakeespn.DefaultLiveGameID, svc, true)
+
+	stale := fetchGameDataCmd(other.ctx, other.session, other.gameID, other.service)()
+	next, cmd := m.Update(stale)
+	if got := next.(Model).summary; got != m.summary {
+		t.Error("a snapshot from another session replaced the summary")
+	}
+	if cmd != nil {
+		t.Error("a snapshot from another session scheduled a poll")
+	}
+}
+
+func TestLiveModelKeepsSummaryWhileReconnecting(t *testing.T) {
+	m := poll(t, NewModel(fakeespn.DefaultLiveGameID, fakeScoreService(t, 1), true))
+
+	next, cmd := m.Update(errorMsg{session: m.session, err: errors.New("connection reset")})
+	m = next.(Model)
+	if !m.reconnecting || m.summary == nil || m.err != nil {
+		t.Errorf("reconnecting=%v summary=%v err=%v, want the last summary kept", m.reconnecting, m.summary != nil, m.err)
+	}
+	if cmd == nil {
+		t.Error("no retry scheduled")
+	}
+}
+
+func TestLiveModelIgnoresStaleEffectTimers(t *testing.T) {
+	m := NewModel(fakeespn.DefaultLiveGameID, nil, true)
+	m.showFireworks, m.flashScore, m.mascotState = true, true, MascotCelebrating
+	m.scoreSeq = 2
+
+	stale := []tea.Msg{
+		clearCelebrationMsg{session: m.session - 1, seq: 2}, // A game the user left
+		clearCelebrationMsg{session: m.session, seq: 1},     // An earlier score
+		clearFlashScoreMsg{session: m.session, seq: 1},
+		clearSadMascotMsg{session: m.session, seq: m.sadSeq}, // Not sad; leave the celebration
+	}
+	for _, msg := range stale {
+		next, _ := m.Update(msg)
+		m = next.(Model)
+		if !m.showFireworks || !m.flashScore || m.mascotState != MascotCelebrating {
+			t.Fatalf("%#v cleared the current celebration", msg)
+		}
+	}
+
+	next, _ := m.Update(clearCelebrationMsg{session: m.session, seq: 2})
+	m = next.(Model)
+	if m.showFireworks || m.flashScore || m.mascotState != MascotNormal {
+		t.Error("the current celebration's own timer didn't clear it")
+	}
+
+	m.flashPlay, m.playSeq = true, 3
+	next, _ = m.Update(clearFlashPlayMsg{session: m.session + 1, seq: 3})
+	if !next.(Model).flashPlay {
+		t.Error("a flash timer from another session cleared the flash")
+	}
+}
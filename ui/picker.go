@@ -0,0 +1,545 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"nfl-scores/calendar"
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// dateLayout is the ESPN `dates` query format
+const dateLayout = "20060102"
+
+// Picker messages
+type pickerDataMsg struct {
+	session int64
+	dates   string
+	games   []models.Game
+	err     error
+}
+
+// embeddable is implemented by views the picker can open in place; an
+// embedded view sends closeGameMsg instead of quitting the program
+type embeddable interface {
+	embed() tea.Model
+}
+
+func (m Model) embed() tea.Model       { m.embedded = true; return m }
+func (m ReplayModel) embed() tea.Model { m.embedded = true; return m }
+func (m StatsModel) embed() tea.Model  { m.embedded = true; return m }
+
+// PickerConfig describes what the picker is choosing a game for
+type PickerConfig struct {
+	Title       string                        // e.g. "Select a game to replay"
+	Dates       string                        // Initial range (YYYYMMDD-YYYYMMDD), empty for the current week
+	Selectable  func(models.Game) bool        // Games Launch accepts; nil allows every game
+	Unavailable string                        // Shown when enter is pressed on a game that isn't selectable
+	Launch      func(gameID string) tea.Model // Builds the view to open for the chosen game
+}
+
+// PickerModel is a filterable game list that opens the chosen game in place
+type PickerModel struct {
+	session   int64
+	ctx       context.Context
+	cancel    context.CancelFunc
+	service   *service.ScoreService
+	cfg       PickerConfig
+	plain     bool
+	dates     string
+	games     []models.Game
+	visible   []int // Indexes into games that match the filter, best match first
+	cursor    int   // Index into visible
+	filter    string
+	filtering bool
+	editDates bool
+	dateInput string
+	notice    string
+	loading   bool
+	err       error
+	width     int
+	height    int
+	child     tea.Model // Game opened with enter, nil while picking
+}
+
+// NewPickerModel creates a game picker
+func NewPickerModel(svc *service.ScoreService, cfg PickerConfig, plain bool) PickerModel {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return PickerModel{
+		session: sessions.Add(1),
+		ctx:     ctx,
+		cancel:  cancel,
+		service: svc,
+		cfg:     cfg,
+		plain:   plain,
+		dates:   cfg.Dates,
+		loading: true,
+		width:   80,
+		height:  24,
+	}
+}
+
+// Init loads the initial date range
+func (m PickerModel) Init() tea.Cmd {
+	return fetchPickerGamesCmd(m.ctx, m.session, m.dates, m.service)
+}
+
+// Update handles messages
+func (m PickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case closeGameMsg:
+		m.child = nil
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+
+	if m.child != nil {
+		var cmd tea.Cmd
+		m.child, cmd = m.child.Update(msg)
+		return m, cmd
+	}
+
+	switch msg := msg.(type) {
+	case pickerDataMsg:
+		// Drop results for a range the user has already moved away from
+		if msg.session != m.session || msg.dates != m.dates {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.games = msg.games
+		m.applyFilter()
+		m.cursor = m.firstSelectable()
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.cancel()
+			return m, tea.Quit
+		}
+		m.notice = ""
+		switch {
+		case m.editDates:
+			return m.updateDateInput(msg)
+		case m.filtering:
+			return m.updateFilterInput(msg)
+		}
+		return m.updateList(msg)
+	}
+
+	return m, nil
+}
+
+// updateList handles keys while browsing the list
+func (m PickerModel) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		m.cancel()
+		return m, tea.Quit
+	case "esc":
+		if m.filter != "" {
+			m.filter = ""
+			m.applyFilter()
+			return m, nil
+		}
+		m.cancel()
+		return m, tea.Quit
+	case "up", "k":
+		m.moveCursor(-1)
+	case "down", "j":
+		m.moveCursor(1)
+	case "pgup":
+		m.moveCursor(-m.listHeight())
+	case "pgdown":
+		m.moveCursor(m.listHeight())
+	case "home", "g":
+		m.cursor = 0
+	case "end", "G":
+		m.cursor = max(0, len(m.visible)-1)
+	case "/":
+		m.filtering = true
+	case "[":
+		return m.load(stepWeek(m.dates, -1, time.Now()))
+	case "]":
+		return m.load(stepWeek(m.dates, 1, time.Now()))
+	case "t":
+		return m.load("")
+	case "d":
+		m.editDates = true
+		m.dateInput = m.dates
+	case "r":
+		return m.load(m.dates)
+	case "enter":
+		return m.launch()
+	}
+	return m, nil
+}
+
+// updateFilterInput handles keys while typing a filter
+func (m PickerModel) updateFilterInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.filtering = false
+		m.filter = ""
+	case tea.KeyEnter:
+		m.filtering = false
+		return m.launch()
+	case tea.KeyUp:
+		m.moveCursor(-1)
+		return m, nil
+	case tea.KeyDown:
+		m.moveCursor(1)
+		return m, nil
+	case tea.KeyBackspace:
+		if m.filter != "" {
+			r := []rune(m.filter)
+			m.filter = string(r[:len(r)-1])
+		}
+	case tea.KeySpace:
+		m.filter += " "
+	case tea.KeyRunes:
+		m.filter += string(msg.Runes)
+	default:
+		return m, nil
+	}
+	m.applyFilter()
+	return m, nil
+}
+
+// updateDateInput handles keys while typing a date range
+func (m PickerModel) updateDateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.editDates = false
+	case tea.KeyEnter:
+		m.editDates = false
+		return m.load(strings.TrimSpace(m.dateInput))
+	case tea.KeyBackspace:
+		if m.dateInput != "" {
+			m.dateInput = m.dateInput[:len(m.dateInput)-1]
+		}
+	case tea.KeyRunes:
+		for _, r := range msg.Runes {
+			if (r >= '0' && r <= '9') || r == '-' {
+				m.dateInput += string(r)
+			}
+		}
+	}
+	return m, nil
+}
+
+// load switches the picker to a new date range
+func (m PickerModel) load(dates string) (tea.Model, tea.Cmd) {
+	m.dates = dates
+	m.loading = true
+	m.err = nil
+	return m, fetchPickerGamesCmd(m.ctx, m.session, dates, m.service)
+}
+
+// launch opens the game under the cursor in place of the picker
+func (m PickerModel) launch() (tea.Model, tea.Cmd) {
+	if m.cursor >= len(m.visible) {
+		return m, nil
+	}
+	g := m.games[m.visible[m.cursor]]
+	if !m.selectable(g) {
+		m.notice = m.cfg.Unavailable
+		return m, nil
+	}
+
+	child := m.cfg.Launch(g.ID)
+	if e, ok := child.(embeddable); ok {
+		child = e.embed()
+	}
+	child, _ = child.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+	m.child = child
+	return m, child.Init()
+}
+
+func (m PickerModel) selectable(g models.Game) bool {
+	return m.cfg.Selectable == nil || m.cfg.Selectable(g)
+}
+
+// firstSelectable returns the first visible game that can be launched
+func (m PickerModel) firstSelectable() int {
+	for i, idx := range m.visible {
+		if m.selectable(m.games[idx]) {
+			return i
+		}
+	}
+	return 0
+}
+
+func (m *PickerModel) moveCursor(delta int) {
+	m.cursor = max(0, min(m.cursor+delta, len(m.visible)-1))
+}
+
+// applyFilter recomputes the visible games, best matches first
+func (m *PickerModel) applyFilter() {
+	type match struct{ idx, score int }
+	var matches []match
+	for i, g := range m.games {
+		if score := fuzzyScore(m.filter, g); score >= 0 {
+			matches = append(matches, match{i, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	m.visible = m.visible[:0]
+	for _, mt := range matches {
+		m.visible = append(m.visible, mt.idx)
+	}
+	if m.cursor >= len(m.visible) {
+		m.cursor = max(0, len(m.visible)-1)
+	}
+	if m.filter != "" {
+		m.cursor = 0
+	}
+}
+
+// fuzzyScore ranks how well query matches either team of g, or -1 if it
+// doesn't. Every word of the query must match a team: an exact abbreviation
+// scores highest, then an abbreviation prefix or name substring, then the
+// query's letters appearing in order in the name ("kcc" for Kansas City Chiefs).
+func fuzzyScore(query string, g models.Game) int {
+	total := 0
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		best := -1
+		for _, t := range []models.Team{g.AwayTeam, g.HomeTeam} {
+			abbr := strings.ToLower(t.Abbreviation)
+			name := strings.ToLower(t.Name)
+			switch {
+			case abbr == word:
+				best = max(best, 3)
+			case strings.HasPrefix(abbr, word), strings.Contains(name, word):
+				best = max(best, 2)
+			case isSubsequence(word, name):
+				best = max(best, 1)
+			}
+		}
+		if best < 0 {
+			return -1
+		}
+		total += best
+	}
+	return total
+}
+
+// isSubsequence reports whether the runes of sub appear in order in s
+func isSubsequence(sub, s string) bool {
+	r := []rune(sub)
+	i := 0
+	for _, c := range s {
+		if i < len(r) && c == r[i] {
+			i++
+		}
+	}
+	return i == len(r)
+}
+
+// stepWeek moves from the NFL week holding the start of a YYYYMMDD[-YYYYMMDD]
+// range (now for the current week) to the previous or next week, across
+// preseason, playoffs and the offseason
+func stepWeek(dates string, dir int, now time.Time) string {
+	from, _, ok := parseDateRange(dates)
+	if !ok {
+		from = now
+	}
+	week, ok := calendar.WeekOf(from)
+	if !ok {
+		// Between seasons or in the gap before week 1: the next week to
+		// come is one step forward
+		week = calendar.Current(from)
+		if dir > 0 {
+			return week.Dates()
+		}
+	}
+	if dir > 0 {
+		return week.Next().Dates()
+	}
+	return week.Prev().Dates()
+}
+
+// parseDateRange parses YYYYMMDD or YYYYMMDD-YYYYMMDD
+func parseDateRange(dates string) (time.Time, time.Time, bool) {
+	from, to, isRange := strings.Cut(dates, "-")
+	start, err := time.Parse(dateLayout, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	if !isRange {
+		return start, start, true
+	}
+	end, err := time.Parse(dateLayout, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, true
+}
+
+// dateRangeLabel describes the loaded range for the header
+func dateRangeLabel(dates string) string {
+	if dates == "" {
+		return "This week"
+	}
+	start, end, ok := parseDateRange(dates)
+	if !ok {
+		return dates
+	}
+	if w, ok := calendar.WeekOf(start); ok && w.Dates() == dates {
+		return fmt.Sprintf("%d %s (%s – %s)", w.Season, w.Label(), start.Format("Jan 2"), end.Format("Jan 2"))
+	}
+	if start.Equal(end) {
+		return start.Format("Mon Jan 2, 2006")
+	}
+	return start.Format("Jan 2") + " – " + end.Format("Jan 2, 2006")
+}
+
+// statusBadge labels a game's state in the list
+func statusBadge(g models.Game) string {
+	switch g.Status {
+	case models.StatusInProgress:
+		return "LIVE"
+	case models.StatusFinal:
+		return "FINAL"
+	default:
+		return "UPCOMING"
+	}
+}
+
+// listHeight is the number of game rows that fit on screen
+func (m PickerModel) listHeight() int {
+	return max(3, m.height-9)
+}
+
+// View renders the picker or the opened game
+func (m PickerModel) View() string {
+	if m.child != nil {
+		return m.child.View()
+	}
+
+	var sb strings.Builder
+	title := m.cfg.Title
+	if title == "" {
+		title = "Select a game"
+	}
+	header := fmt.Sprintf("%s  ·  %s", title, dateRangeLabel(m.dates))
+	help := "  ↑↓: move • Enter: open • /: filter • [ ]: prev/next week • d: dates • t: this week • q: quit"
+
+	var (
+		titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Background(lipgloss.Color("235")).Padding(0, 2)
+		dimStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+		selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("226")).Background(lipgloss.Color("236"))
+		noticeStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	)
+	render := func(style lipgloss.Style, s string) string {
+		if m.plain {
+			return s
+		}
+		return style.Render(s)
+	}
+
+	if m.plain {
+		sb.WriteString("\n  " + header + "\n\n")
+	} else {
+		sb.WriteString(titleStyle.Render(header) + "\n\n")
+	}
+
+	switch {
+	case m.loading:
+		sb.WriteString("   Loading games...\n")
+	case m.err != nil:
+		sb.WriteString(fmt.Sprintf("   Error: %v\n", m.err))
+	case len(m.games) == 0:
+		sb.WriteString("   No games in this range.\n")
+	case len(m.visible) == 0:
+		sb.WriteString("   No games match the filter.\n")
+	default:
+		height := m.listHeight()
+		start := max(0, min(m.cursor-height/2, len(m.visible)-height))
+		end := min(start+height, len(m.visible))
+		for i := start; i < end; i++ {
+			g := m.games[m.visible[i]]
+			line := m.renderRow(g, i == m.cursor)
+			switch {
+			case i == m.cursor:
+				line = render(selectedStyle, line)
+			case !m.selectable(g):
+				line = render(dimStyle, line)
+			}
+			sb.WriteString(line + "\n")
+		}
+		if len(m.visible) > height {
+			sb.WriteString(render(dimStyle, fmt.Sprintf("   %d-%d of %d", start+1, end, len(m.visible))) + "\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	switch {
+	case m.editDates:
+		sb.WriteString(fmt.Sprintf("  Dates (YYYYMMDD or YYYYMMDD-YYYYMMDD): %s_\n", m.dateInput))
+	case m.filtering:
+		sb.WriteString(fmt.Sprintf("  Filter: %s_\n", m.filter))
+	case m.filter != "":
+		sb.WriteString(fmt.Sprintf("  Filter: %s  (esc to clear)\n", m.filter))
+	default:
+		sb.WriteString("\n")
+	}
+	if m.notice != "" {
+		sb.WriteString(render(noticeStyle, "  "+m.notice) + "\n")
+	}
+	sb.WriteString(render(dimStyle, help) + "\n")
+	return sb.String()
+}
+
+// renderRow formats one game line with its status badge
+func (m PickerModel) renderRow(g models.Game, selected bool) string {
+	cursor := "  "
+	if selected {
+		cursor = "> "
+	}
+	badge := fmt.Sprintf("[%s]", statusBadge(g))
+	if !m.plain && !selected {
+		badge = badgeStyle(g).Render(statusBadge(g))
+	}
+	score := fmt.Sprintf("%-4s %3d  @  %-4s %3d", g.AwayTeam.Abbreviation, g.AwayTeam.Score,
+		g.HomeTeam.Abbreviation, g.HomeTeam.Score)
+	if g.Status == models.StatusScheduled {
+		score = fmt.Sprintf("%-4s      @  %-4s    ", g.AwayTeam.Abbreviation, g.HomeTeam.Abbreviation)
+	}
+	return fmt.Sprintf(" %s%s  %-10s %s", cursor, score, badge, g.StatusText)
+}
+
+// badgeStyle colors the status badge by game state
+func badgeStyle(g models.Game) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(true).Padding(0, 1).Width(10)
+	switch g.Status {
+	case models.StatusInProgress:
+		return style.Foreground(lipgloss.Color("255")).Background(lipgloss.Color("160"))
+	case models.StatusFinal:
+		return style.Foreground(lipgloss.Color("252")).Background(lipgloss.Color("238"))
+	default:
+		return style.Foreground(lipgloss.Color("255")).Background(lipgloss.Color("25"))
+	}
+}
+
+// Commands
+func fetchPickerGamesCmd(ctx context.Context, session int64, dates string, svc *service.ScoreService) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+		defer cancel()
+
+		games, err := svc.GetScoresByDates(ctx, dates)
+		return pickerDataMsg{session: session, dates: dates, games: games, err: err}
+	}
+}
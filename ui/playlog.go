@@ -0,0 +1,316 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"nfl-scores/models"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// playLogChrome is how many rows the log view spends outside the log: the
+// score header, a banner row, the title and the help line
+const playLogChrome = 7
+
+// playLog is the live view's scrollable log of every play so far, grouped
+// by quarter and drive, oldest first. It follows the newest play until the
+// user scrolls up, and scrolls back to following at the bottom.
+type playLog struct {
+	viewport  viewport.Model
+	plain     bool
+	follow    bool        // Keep the newest play in view
+	quarters  map[int]int // First line of each period
+	playLines []int       // First line of each play, by index into Plays
+	query     string
+	searching bool  // Typing a query
+	matches   []int // Indexes into Plays whose text matches query
+	match     int   // Current match
+}
+
+func newPlayLog(plain bool) playLog {
+	return playLog{viewport: viewport.New(80, 10), plain: plain, follow: true}
+}
+
+// setSize fits the log to the terminal
+func (l *playLog) setSize(width, height int) {
+	l.viewport.Width = width
+	l.viewport.Height = max(3, height-playLogChrome)
+}
+
+// setSummary re-renders the log, keeping the newest play in view when
+// following and the scroll position otherwise
+func (l *playLog) setSummary(s *models.GameSummary) {
+	if s == nil {
+		return
+	}
+	l.viewport.SetContent(l.render(s))
+	if l.follow {
+		l.viewport.GotoBottom()
+	}
+}
+
+// render lays out every play under quarter and drive headings, wrapping
+// long text, and records where each quarter and play starts
+func (l *playLog) render(s *models.GameSummary) string {
+	l.quarters = make(map[int]int)
+	l.playLines = make([]int, len(s.Plays))
+	l.matches = l.matches[:0]
+	query := strings.ToLower(l.query)
+
+	drives := make(map[int]models.ReplayDrive, len(s.Drives))
+	for _, d := range s.Drives {
+		drives[d.StartIndex] = d
+	}
+
+	dim := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	quarterStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	scoringStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("226")).Background(lipgloss.Color("22"))
+	matchStyle := lipgloss.NewStyle().Reverse(true)
+	textWidth := max(20, l.viewport.Width-12)
+
+	var lines []string
+	period := 0
+	for i, p := range s.Plays {
+		if p.Period != period {
+			period = p.Period
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			l.quarters[period] = len(lines)
+			heading := "── " + periodName(period) + " " + strings.Repeat("─", max(0, l.viewport.Width-len(periodName(period))-6))
+			if l.plain {
+				heading = "== " + periodName(period) + " =="
+			} else {
+				heading = quarterStyle.Render(heading)
+			}
+			lines = append(lines, heading)
+		}
+		if d, ok := drives[i]; ok {
+			team, desc := fmt.Sprintf(" %-4s", d.Team), d.Description
+			if d.Result != "" {
+				desc += " · " + d.Result
+			}
+			if !l.plain {
+				color := lipgloss.Color("255")
+				if c, ok := teamColors[d.Team]; ok {
+					color = lipgloss.Color(c)
+				}
+				team = lipgloss.NewStyle().Foreground(color).Bold(true).Render(team)
+				desc = dim.Render(desc)
+			}
+			lines = append(lines, team+" "+desc)
+		}
+
+		matched := query != "" && strings.Contains(strings.ToLower(p.Text), query)
+		if matched {
+			l.matches = append(l.matches, i)
+		}
+		mark := "  "
+		switch {
+		case matched && l.plain:
+			mark = "> "
+		case p.ScoringPlay && l.plain:
+			mark = "* "
+		}
+
+		l.playLines[i] = len(lines)
+		text := strings.TrimSpace(strings.ReplaceAll(p.Text, "\n", " "))
+		for j, part := range wrapWords(text, textWidth) {
+			clock := fmt.Sprintf("%6s", p.Clock)
+			if j > 0 {
+				clock = strings.Repeat(" ", 6)
+			}
+			if !l.plain {
+				clock = dim.Render(clock)
+				switch {
+				case matched:
+					part = matchStyle.Render(part)
+				case p.ScoringPlay:
+					part = scoringStyle.Render(part)
+				}
+			}
+			lines = append(lines, fmt.Sprintf("  %s%s  %s", mark, clock, part))
+			mark = "  "
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "  No plays yet.")
+	}
+	l.match = min(l.match, max(0, len(l.matches)-1))
+	return strings.Join(lines, "\n")
+}
+
+// periodName is a period's heading, e.g. "1st QUARTER" or "OVERTIME"
+func periodName(period int) string {
+	switch period {
+	case 1:
+		return "1st QUARTER"
+	case 2:
+		return "2nd QUARTER"
+	case 3:
+		return "3rd QUARTER"
+	case 4:
+		return "4th QUARTER"
+	case 5:
+		return "OVERTIME"
+	}
+	return fmt.Sprintf("OVERTIME %d", period-4)
+}
+
+// wrapWords breaks s into lines of at most width runes at spaces
+func wrapWords(s string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	for _, word := range strings.Fields(s) {
+		if line.Len() > 0 && len([]rune(line.String()))+1+len([]rune(word)) > width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(word)
+	}
+	if line.Len() > 0 || len(lines) == 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// scrollTo puts line at the top of the log
+func (l *playLog) scrollTo(line int) {
+	l.viewport.SetYOffset(line)
+	l.follow = l.viewport.AtBottom()
+}
+
+// jumpQuarter scrolls to the start of period; 5 means the first overtime
+func (l *playLog) jumpQuarter(period int) {
+	if line, ok := l.quarters[period]; ok {
+		l.scrollTo(line)
+	}
+}
+
+// jumpMatch moves to the next (step 1) or previous (step -1) search match
+func (l *playLog) jumpMatch(step int) {
+	if len(l.matches) == 0 {
+		return
+	}
+	l.match = (l.match + step + len(l.matches)) % len(l.matches)
+	l.scrollTo(max(0, l.playLines[l.matches[l.match]]-2))
+}
+
+// firstMatch moves to the first match at or below the top of the log, so
+// typing a query keeps the view near where the user was reading
+func (l *playLog) firstMatch() {
+	if len(l.matches) == 0 {
+		return
+	}
+	l.match = 0
+	for i, idx := range l.matches {
+		if l.playLines[idx] >= l.viewport.YOffset {
+			l.match = i
+			break
+		}
+	}
+	l.scrollTo(max(0, l.playLines[l.matches[l.match]]-2))
+}
+
+// page is the page of the log on screen and how many there are
+func (l *playLog) page() (int, int) {
+	h := max(1, l.viewport.Height)
+	total := max(1, (l.viewport.TotalLineCount()+h-1)/h)
+	return min(total, l.viewport.YOffset/h+1), total
+}
+
+// updateSearch handles keys while typing a query, re-running the search on
+// every change
+func (l *playLog) updateSearch(msg tea.KeyMsg, s *models.GameSummary) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		l.searching = false
+		l.query = ""
+	case tea.KeyEnter:
+		l.searching = false
+		return
+	case tea.KeyBackspace:
+		if l.query != "" {
+			r := []rune(l.query)
+			l.query = string(r[:len(r)-1])
+		}
+	case tea.KeySpace:
+		l.query += " "
+	case tea.KeyRunes:
+		l.query += string(msg.Runes)
+	default:
+		return
+	}
+	l.setSummary(s)
+	l.firstMatch()
+}
+
+// update handles keys and mouse wheel while the log is open
+func (l *playLog) update(msg tea.Msg, s *models.GameSummary) tea.Cmd {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		if l.searching {
+			l.updateSearch(key, s)
+			return nil
+		}
+		switch key.String() {
+		case "/":
+			l.searching = true
+			l.query = ""
+			l.setSummary(s)
+			return nil
+		case "n":
+			l.jumpMatch(1)
+			return nil
+		case "N":
+			l.jumpMatch(-1)
+			return nil
+		case "1", "2", "3", "4", "5":
+			l.jumpQuarter(int(key.Runes[0] - '0'))
+			return nil
+		case "home", "g":
+			l.viewport.GotoTop()
+			l.follow = l.viewport.AtBottom()
+			return nil
+		case "end", "G":
+			l.viewport.GotoBottom()
+			l.follow = true
+			return nil
+		}
+	}
+	var cmd tea.Cmd
+	l.viewport, cmd = l.viewport.Update(msg)
+	l.follow = l.viewport.AtBottom()
+	return cmd
+}
+
+// help is the log's key help and status: page, follow state and search
+func (l *playLog) help() string {
+	page, pages := l.page()
+	status := fmt.Sprintf("page %d/%d", page, pages)
+	if l.follow {
+		status += " · following"
+	} else {
+		status += " · paused (End to follow)"
+	}
+	switch {
+	case l.searching:
+		return fmt.Sprintf("  Search: %s_  (%d matches, Enter to keep, Esc to clear)", l.query, len(l.matches))
+	case l.query != "":
+		current := 0
+		if len(l.matches) > 0 {
+			current = l.match + 1
+		}
+		status += fmt.Sprintf(" · %q %d/%d (n/N)", l.query, current, len(l.matches))
+	}
+	sep := " • "
+	if l.plain {
+		sep = " | "
+	}
+	return "  " + strings.Join([]string{status, "↑↓/PgUp/PgDn scroll", "1-5 quarter", "/ search", "l: back"}, sep)
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -12,13 +13,21 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Replay messages
-type replayTickMsg time.Time
-type replayDataMsg *models.GameReplay
-type replayErrorMsg error
+// Replay messages are session-tagged like the live ones
+type replayTickMsg struct{ session int64 }
+type replayDataMsg struct {
+	session int64
+	replay  *models.GameReplay
+}
+type replayErrorMsg struct {
+	session int64
+	err     error
+}
+type replayAutoTickMsg struct{ session int64 }
 
 // ReplayModel holds the replay UI state
 type ReplayModel struct {
+	session     int64
 	gameID      string
 	service     *service.ScoreService
 	replay      *models.GameReplay
@@ -32,11 +41,20 @@ type ReplayModel struct {
 	height      int
 	showMascot  bool
 	mascotFrame int
+	embedded    bool // Opened from the picker; q/esc returns there instead of quitting
+	showDrives  bool // Drive chart in place of the field
+	driveCursor int  // Drive selected in the chart
+	prompt      replayPrompt
+	input       string // Typed at the prompt
+	query       string // Last search, for , and .
+	searchFrom  int    // Play a search started from; esc goes back there
+	notice      string // Why the last seek went nowhere
 }
 
 // NewReplayModel creates a new replay UI model
 func NewReplayModel(gameID string, svc *service.ScoreService, plain, mascot bool) ReplayModel {
 	return ReplayModel{
+		session:    sessions.Add(1),
 		gameID:     gameID,
 		service:    svc,
 		loading:    true,
@@ -53,8 +71,8 @@ func NewReplayModel(gameID string, svc *service.ScoreService, plain, mascot bool
 // Init initializes the replay model
 func (m ReplayModel) Init() tea.Cmd {
 	return tea.Batch(
-		fetchReplayDataCmd(m.gameID, m.service),
-		replayMascotTickCmd(),
+		fetchReplayDataCmd(m.session, m.gameID, m.service),
+		replayMascotTickCmd(m.session),
 	)
 }
 
@@ -62,9 +80,42 @@ func (m ReplayModel) Init() tea.Cmd {
 func (m ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		m.notice = ""
+		if m.prompt != promptNone && m.replay != nil && msg.String() != "ctrl+c" {
+			return m.updatePrompt(msg)
+		}
 		switch msg.String() {
 		case "q", "ctrl+c", "esc":
+			if m.embedded && msg.String() != "ctrl+c" {
+				return m, func() tea.Msg { return closeGameMsg{} }
+			}
 			return m, tea.Quit
+		case "d":
+			// Toggle the drive chart, starting at the drive on screen
+			m.showDrives = !m.showDrives
+			if m.replay != nil {
+				m.driveCursor = max(0, driveIndex(m.replay, m.playIndex))
+			}
+		case "[", "]":
+			// Previous / next drive
+			if m.replay != nil {
+				m.jumpDrive(msg.String() == "]")
+			}
+		case "up", "k":
+			if m.showDrives && m.driveCursor > 0 {
+				m.driveCursor--
+			}
+		case "down", "j":
+			if m.showDrives && m.replay != nil && m.driveCursor < len(m.replay.Drives)-1 {
+				m.driveCursor++
+			}
+		case "enter":
+			// Jump to the selected drive's first play
+			if m.showDrives && m.replay != nil && m.driveCursor < len(m.replay.Drives) {
+				if d := m.replay.Drives[m.driveCursor]; d.StartIndex <= d.EndIndex {
+					m.playIndex = d.StartIndex
+				}
+			}
 		case "right", "l", "n":
 			// Next play
 			if m.replay != nil && m.playIndex < len(m.replay.Plays)-1 {
@@ -79,7 +130,7 @@ func (m ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Toggle auto-play
 			m.autoPlay = !m.autoPlay
 			if m.autoPlay {
-				return m, replayAutoTickCmd(m.autoSpeed)
+				return m, replayAutoTickCmd(m.session, m.autoSpeed)
 			}
 		case "+", "=":
 			// Speed up
@@ -100,27 +151,48 @@ func (m ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.playIndex = len(m.replay.Plays) - 1
 			}
 		}
+		if m.replay != nil && len(m.replay.Plays) > 0 {
+			m.updateSeek(msg.String())
+		}
+
+	case tea.MouseMsg:
+		// Click or drag on the progress bar
+		if msg.Button == tea.MouseButtonLeft && (msg.Action == tea.MouseActionPress || msg.Action == tea.MouseActionMotion) {
+			m.seekMouse(msg.X, msg.Y)
+		}
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
 
 	case replayTickMsg:
+		if msg.session != m.session {
+			return m, nil
+		}
 		m.mascotFrame++
-		return m, replayMascotTickCmd()
+		return m, replayMascotTickCmd(m.session)
 
 	case replayDataMsg:
+		if msg.session != m.session {
+			return m, nil
+		}
 		m.loading = false
-		m.replay = msg
+		m.replay = msg.replay
 
 	case replayErrorMsg:
-		m.err = msg
+		if msg.session != m.session {
+			return m, nil
+		}
+		m.err = msg.err
 		m.loading = false
 
 	case replayAutoTickMsg:
+		if msg.session != m.session {
+			return m, nil
+		}
 		if m.autoPlay && m.replay != nil && m.playIndex < len(m.replay.Plays)-1 {
 			m.playIndex++
-			return m, replayAutoTickCmd(m.autoSpeed)
+			return m, replayAutoTickCmd(m.session, m.autoSpeed)
 		}
 		m.autoPlay = false
 	}
@@ -128,6 +200,53 @@ func (m ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateSeek handles the keys that jump around the game
+func (m *ReplayModel) updateSeek(key string) {
+	switch key {
+	case "1", "2", "3", "4", "5":
+		m.jumpQuarter(int(key[0] - '0'))
+	case "g":
+		m.prompt, m.input = promptGoTo, ""
+	case "/":
+		m.prompt, m.input, m.searchFrom = promptSearch, "", m.playIndex
+	case "s":
+		m.seek(1, "scoring plays", isScoring)
+	case "S":
+		m.seek(-1, "scoring plays", isScoring)
+	case "t":
+		m.seek(1, "turnovers", isTurnover)
+	case "T":
+		m.seek(-1, "turnovers", isTurnover)
+	case ".", ",":
+		if m.query == "" {
+			m.notice = "Press / to search first"
+			return
+		}
+		step := 1
+		if key == "," {
+			step = -1
+		}
+		m.jumpTo(m.findMatch(m.query, m.playIndex, step), fmt.Sprintf("No play matches %q", m.query))
+	}
+}
+
+// jumpDrive moves to the first play of the next or previous drive that
+// has plays, and selects it in the drive chart
+func (m *ReplayModel) jumpDrive(next bool) {
+	current := driveIndex(m.replay, m.playIndex)
+	step := -1
+	if next {
+		step = 1
+	}
+	for i := current + step; i >= 0 && i < len(m.replay.Drives); i += step {
+		if d := m.replay.Drives[i]; d.StartIndex <= d.EndIndex {
+			m.playIndex = d.StartIndex
+			m.driveCursor = i
+			return
+		}
+	}
+}
+
 // View renders the replay UI
 func (m ReplayModel) View() string {
 	if m.loading {
@@ -161,15 +280,23 @@ func (m ReplayModel) renderPlain() string {
 	sb.WriteString(strings.Repeat("=", 70) + "\n\n")
 
 	// Progress
-	sb.WriteString(fmt.Sprintf("  Play %d of %d\n", m.playIndex+1, len(m.replay.Plays)))
+	prefix, bar := m.progressLine()
+	sb.WriteString(prefix + bar + "]\n")
 	sb.WriteString(fmt.Sprintf("  Q%d %s\n\n", play.Period, play.Clock))
+	if chart := renderWinChart(m.replay, m.playIndex, winChartWidth, true); chart != "" {
+		sb.WriteString(chart + "\n")
+	}
 
-	// Field
+	// Field, or the drive chart
 	yardsToEndzone := play.YardsToEndzone
 	if yardsToEndzone == 0 {
 		yardsToEndzone = 50
 	}
-	sb.WriteString(RenderField(yardsToEndzone, play.Possession, true))
+	if m.showDrives {
+		sb.WriteString(renderDriveChart(m.replay, driveIndex(m.replay, m.playIndex), m.driveCursor, true))
+	} else {
+		sb.WriteString(RenderField(yardsToEndzone, play.Possession, true))
+	}
 
 	// Situation
 	if play.Down != "" {
@@ -188,6 +315,8 @@ func (m ReplayModel) renderPlain() string {
 		autoStatus = fmt.Sprintf("ON (%ds)", m.autoSpeed)
 	}
 	sb.WriteString(fmt.Sprintf("  ←/→: prev/next | SPACE: auto-play [%s] | +/-: speed | q: quit\n", autoStatus))
+	sb.WriteString(m.driveHelp() + "\n")
+	sb.WriteString(m.seekHelp() + "\n")
 
 	return sb.String()
 }
@@ -248,12 +377,11 @@ func (m ReplayModel) renderStyled() string {
 	sb.WriteString(border + "\n")
 
 	// Progress bar
-	progress := float64(m.playIndex+1) / float64(len(m.replay.Plays))
-	barWidth := 50
-	filled := int(progress * float64(barWidth))
-	progressBar := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(strings.Repeat("█", filled))
-	progressBar += lipgloss.NewStyle().Foreground(lipgloss.Color("236")).Render(strings.Repeat("░", barWidth-filled))
-	sb.WriteString(fmt.Sprintf("\n  Play %d/%d  [%s]\n", m.playIndex+1, len(m.replay.Plays), progressBar))
+	prefix, bar := m.progressLine()
+	sb.WriteString("\n" + prefix + bar + "]\n")
+	if chart := renderWinChart(m.replay, m.playIndex, winChartWidth, false); chart != "" {
+		sb.WriteString("\n" + chart)
+	}
 
 	// Field
 	yardsToEndzone := play.YardsToEndzone
@@ -263,7 +391,9 @@ func (m ReplayModel) renderStyled() string {
 
 	sb.WriteString("\n")
 	fieldStr := RenderField(yardsToEndzone, play.Possession, false)
-	if m.showMascot && play.Possession != "" {
+	if m.showDrives {
+		sb.WriteString(renderDriveChart(m.replay, driveIndex(m.replay, m.playIndex), m.driveCursor, false))
+	} else if m.showMascot && play.Possession != "" {
 		state := MascotNormal
 		if play.ScoringPlay {
 			state = MascotCelebrating
@@ -300,31 +430,50 @@ func (m ReplayModel) renderStyled() string {
 	}
 	controls := fmt.Sprintf("  ←/→: prev/next • SPACE: auto [%s] • +/-: speed • HOME/END: jump • q: quit", autoStatus)
 	sb.WriteString(statusStyle.Render(controls) + "\n")
+	sb.WriteString(statusStyle.Render(m.driveHelp()) + "\n")
+	seekStyle := statusStyle
+	if m.prompt != promptNone || m.notice != "" {
+		seekStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	}
+	sb.WriteString(seekStyle.Render(m.seekHelp()) + "\n")
 
 	return sb.String()
 }
 
+// driveHelp lists the drive keys, which change while the chart is open
+func (m ReplayModel) driveHelp() string {
+	help := "  [/]: prev/next drive • d: drive chart"
+	if m.showDrives {
+		help = "  [/]: prev/next drive • ↑/↓: select drive • ENTER: jump to drive • d: field"
+	}
+	if m.plain {
+		help = strings.ReplaceAll(help, " • ", " | ")
+	}
+	return help
+}
+
 // Commands
-func fetchReplayDataCmd(gameID string, svc *service.ScoreService) tea.Cmd {
+func fetchReplayDataCmd(session int64, gameID string, svc *service.ScoreService) tea.Cmd {
 	return func() tea.Msg {
-		replay, err := svc.GetGameReplay(gameID)
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
+
+		replay, err := svc.GetGameReplay(ctx, gameID)
 		if err != nil {
-			return replayErrorMsg(err)
+			return replayErrorMsg{session: session, err: err}
 		}
-		return replayDataMsg(replay)
+		return replayDataMsg{session: session, replay: replay}
 	}
 }
 
-type replayAutoTickMsg time.Time
-
-func replayAutoTickCmd(seconds int) tea.Cmd {
-	return tea.Tick(time.Duration(seconds)*time.Second, func(t time.Time) tea.Msg {
-		return replayAutoTickMsg(t)
+func replayAutoTickCmd(session int64, seconds int) tea.Cmd {
+	return tea.Tick(time.Duration(seconds)*time.Second, func(time.Time) tea.Msg {
+		return replayAutoTickMsg{session: session}
 	})
 }
 
-func replayMascotTickCmd() tea.Cmd {
-	return tea.Tick(300*time.Millisecond, func(t time.Time) tea.Msg {
-		return replayTickMsg(t)
+func replayMascotTickCmd(session int64) tea.Cmd {
+	return tea.Tick(300*time.Millisecond, func(time.Time) tea.Msg {
+		return replayTickMsg{session: session}
 	})
 }
@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// replayBarWidth is the progress bar's width; clicking or dragging on it
+// seeks
+const replayBarWidth = 50
+
+// replayPrompt is what the replay's input line is asking for
+type replayPrompt int
+
+const (
+	promptNone replayPrompt = iota
+	promptGoTo
+	promptSearch
+)
+
+// updatePrompt handles keys while typing a go-to time or a search. Search
+// moves as you type, starting from the play the search began on.
+func (m ReplayModel) updatePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		if m.prompt == promptSearch {
+			m.playIndex = m.searchFrom
+		}
+		m.prompt, m.input = promptNone, ""
+		return m, nil
+	case tea.KeyEnter:
+		if m.prompt == promptGoTo {
+			m.goTo(m.input)
+		} else if m.input != "" {
+			m.query = m.input
+		}
+		m.prompt, m.input = promptNone, ""
+		return m, nil
+	case tea.KeyBackspace:
+		if m.input != "" {
+			r := []rune(m.input)
+			m.input = string(r[:len(r)-1])
+		}
+	case tea.KeySpace:
+		m.input += " "
+	case tea.KeyRunes:
+		m.input += string(msg.Runes)
+	default:
+		return m, nil
+	}
+
+	if m.prompt == promptSearch {
+		m.playIndex = m.searchFrom
+		if m.input == "" {
+			return m, nil
+		}
+		// The start play counts, then wrap around to the top
+		if i := m.findMatch(m.input, m.searchFrom-1, 1); i >= 0 {
+			m.playIndex = i
+		} else {
+			m.notice = "No play matches " + fmt.Sprintf("%q", m.input)
+		}
+	}
+	return m, nil
+}
+
+// goTo jumps to the first play at a time like "Q3 5:30"
+func (m *ReplayModel) goTo(input string) {
+	period, secs, err := service.ParseGameTime(input)
+	if err != nil {
+		m.notice = err.Error()
+		return
+	}
+	m.jumpTo(service.PlayAtTime(m.replay.Plays, period, secs), "No "+periodLabel(period)+" in this game")
+}
+
+// jumpQuarter moves to the first play of period
+func (m *ReplayModel) jumpQuarter(period int) {
+	m.jumpTo(service.PlayAtTime(m.replay.Plays, period, 15*60), "No "+periodLabel(period)+" in this game")
+}
+
+// seek moves to the next (step 1) or previous (step -1) play that match
+// accepts, or says there isn't one
+func (m *ReplayModel) seek(step int, what string, match func(models.ReplayPlay) bool) {
+	direction := "more"
+	if step < 0 {
+		direction = "earlier"
+	}
+	m.jumpTo(service.SeekPlay(m.replay.Plays, m.playIndex, step, match), fmt.Sprintf("No %s %s", direction, what))
+}
+
+// jumpTo moves to play i, or shows notice when i is -1
+func (m *ReplayModel) jumpTo(i int, notice string) {
+	if i < 0 {
+		m.notice = notice
+		return
+	}
+	m.playIndex = i
+	if d := driveIndex(m.replay, i); d >= 0 {
+		m.driveCursor = d
+	}
+}
+
+// findMatch is the next play after from (step 1) or before it (step -1)
+// whose text contains query, wrapping around the game; -1 if none does
+func (m ReplayModel) findMatch(query string, from, step int) int {
+	query = strings.ToLower(query)
+	n := len(m.replay.Plays)
+	for k := 1; k <= n; k++ {
+		i := ((from+step*k)%n + n) % n
+		if strings.Contains(strings.ToLower(m.replay.Plays[i].Text), query) {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchCount is how many plays contain query, and which of them is on
+// screen (0 when the current play doesn't match)
+func (m ReplayModel) matchCount(query string) (current, total int) {
+	query = strings.ToLower(query)
+	for i, p := range m.replay.Plays {
+		if strings.Contains(strings.ToLower(p.Text), query) {
+			total++
+			if i == m.playIndex {
+				current = total
+			}
+		}
+	}
+	return current, total
+}
+
+// isScoring and isTurnover pick plays for the s/S and t/T keys
+func isScoring(p models.ReplayPlay) bool  { return p.ScoringPlay }
+func isTurnover(p models.ReplayPlay) bool { return service.ClassifyReplayPlay(p).IsTurnover() }
+
+// periodLabel names a period for notices, e.g. "Q3" or "OT"
+func periodLabel(period int) string {
+	switch {
+	case period == 5:
+		return "OT"
+	case period > 5:
+		return fmt.Sprintf("OT%d", period-4)
+	}
+	return fmt.Sprintf("Q%d", period)
+}
+
+// progressLine is the "Play 12/170  [████░░░░]" line. prefix comes before
+// the bar, so a click's column can be mapped onto it.
+func (m ReplayModel) progressLine() (prefix, bar string) {
+	n := len(m.replay.Plays)
+	filled := (m.playIndex + 1) * replayBarWidth / n
+	if m.plain {
+		prefix = fmt.Sprintf("  Play %d of %d  [", m.playIndex+1, n)
+		return prefix, strings.Repeat("#", filled) + strings.Repeat(".", replayBarWidth-filled)
+	}
+	prefix = fmt.Sprintf("  Play %d/%d  [", m.playIndex+1, n)
+	bar = lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(lipgloss.Color("236")).Render(strings.Repeat("░", replayBarWidth-filled))
+	return prefix, bar
+}
+
+// progressRow is the screen row of the progress bar: below the score header
+// and a blank line, one lower in plain mode for its leading blank line
+func (m ReplayModel) progressRow() int {
+	if m.plain {
+		return 5
+	}
+	return 4
+}
+
+// seekMouse jumps to the play under a click or drag on the progress bar
+func (m *ReplayModel) seekMouse(x, y int) {
+	if m.replay == nil || len(m.replay.Plays) == 0 || y != m.progressRow() {
+		return
+	}
+	prefix, _ := m.progressLine()
+	col := x - lipgloss.Width(prefix)
+	if col < 0 || col >= replayBarWidth {
+		return
+	}
+	n := len(m.replay.Plays)
+	m.notice = ""
+	m.jumpTo(min(n-1, (col*(n-1)+(replayBarWidth-1)/2)/(replayBarWidth-1)), "")
+}
+
+// seekHelp is the seeking keys, the prompt being typed, or the last
+// seek's notice
+func (m ReplayModel) seekHelp() string {
+	var help string
+	switch {
+	case m.prompt == promptGoTo:
+		return fmt.Sprintf("  Go to (e.g. Q3 5:30, OT): %s_  (Enter to jump, Esc to cancel)", m.input)
+	case m.prompt == promptSearch:
+		_, total := m.matchCount(m.input)
+		return fmt.Sprintf("  Search: %s_  (%d plays match, Enter to keep, Esc to go back)", m.input, total)
+	case m.notice != "":
+		return "  " + m.notice
+	case m.query != "":
+		current, total := m.matchCount(m.query)
+		help = fmt.Sprintf("  1-5: quarter • g: go to • s/S: score • t/T: turnover • /: search • ,/.: %q %d/%d", m.query, current, total)
+	default:
+		help = "  1-5: quarter • g: go to • s/S: score • t/T: turnover • /: search • click bar: seek"
+	}
+	if m.plain {
+		help = strings.ReplaceAll(help, " • ", " | ")
+	}
+	return help
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"nfl-scores/formatter"
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Stats messages
+type statsDataMsg struct {
+	session int64
+	stats   *models.GameStats
+	err     error
+}
+
+// StatsModel shows a scrollable box score inside the TUI
+type StatsModel struct {
+	session  int64
+	gameID   string
+	service  *service.ScoreService
+	plain    bool
+	stats    *models.GameStats
+	loading  bool
+	err      error
+	viewport viewport.Model
+	width    int
+	height   int
+	embedded bool // Opened from the picker; q/esc returns there instead of quitting
+}
+
+// NewStatsModel creates a box score viewer for gameID
+func NewStatsModel(gameID string, svc *service.ScoreService, plain bool) StatsModel {
+	return StatsModel{
+		session:  sessions.Add(1),
+		gameID:   gameID,
+		service:  svc,
+		plain:    plain,
+		loading:  true,
+		viewport: viewport.New(80, 22),
+		width:    80,
+		height:   24,
+	}
+}
+
+// Init fetches the box score
+func (m StatsModel) Init() tea.Cmd {
+	return fetchStatsCmd(m.session, m.gameID, m.service)
+}
+
+// Update handles messages
+func (m StatsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			if m.embedded && msg.String() != "ctrl+c" {
+				return m, func() tea.Msg { return closeGameMsg{} }
+			}
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.viewport.Width = msg.Width
+		m.viewport.Height = max(1, msg.Height-2)
+		m.setContent()
+		return m, nil
+
+	case statsDataMsg:
+		if msg.session != m.session {
+			return m, nil
+		}
+		m.loading = false
+		m.stats = msg.stats
+		m.err = msg.err
+		m.setContent()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// setContent re-renders the box score at the current width
+func (m *StatsModel) setContent() {
+	if m.stats == nil {
+		return
+	}
+	f := formatter.NewTerminalFormatter(m.width, m.plain)
+	m.viewport.SetContent(f.FormatGameStats(m.stats))
+}
+
+// View renders the box score
+func (m StatsModel) View() string {
+	if m.loading {
+		return "\n\n   Loading game stats...\n"
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("\n\n   Error: %v\n\n   Press q to quit.\n", m.err)
+	}
+
+	quit := "q: quit"
+	if m.embedded {
+		quit = "q/esc: back"
+	}
+	help := fmt.Sprintf("  ↑↓/PgUp/PgDn: scroll • %s  (%3.0f%%)", quit, m.viewport.ScrollPercent()*100)
+	if !m.plain {
+		help = lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(help)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(m.viewport.View() + "\n")
+	sb.WriteString(help)
+	return sb.String()
+}
+
+// Commands
+func fetchStatsCmd(session int64, gameID string, svc *service.ScoreService) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
+
+		stats, err := svc.GetGameStats(ctx, gameID)
+		return statsDataMsg{session: session, stats: stats, err: err}
+	}
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"nfl-scores/models"
+	"nfl-scores/service"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Sparkline levels, lowest first: eighth blocks, or ASCII for plain mode
+var (
+	sparkLevels      = []rune("▁▂▃▄▅▆▇█")
+	plainSparkLevels = []rune("_.,-=+*#")
+)
+
+// winChartWidth is the replay sparkline's width in columns, at most one
+// per play
+const winChartWidth = 56
+
+// winSwingCount is how many of the biggest swings the replay chart marks
+const winSwingCount = 3
+
+// winProbabilityText is each team's chance to win, away first like the
+// score line, e.g. "BUF 38% · KC 62%"; "" without a number
+func winProbabilityText(g models.Game, wp *models.WinProbability) string {
+	if wp == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %s · %s %s",
+		g.AwayTeam.Abbreviation, percent(wp.Away()),
+		g.HomeTeam.Abbreviation, percent(wp.Home))
+}
+
+// percent formats a 0-1 probability as a whole percentage
+func percent(p float64) string {
+	return fmt.Sprintf("%.0f%%", p*100)
+}
+
+// renderWinChart draws the home team's win probability across a replay as
+// a sparkline, with a cursor under the current play and the biggest swings
+// marked. It returns "" when ESPN has no win probability for the game.
+func renderWinChart(r *models.GameReplay, index, width int, plain bool) string {
+	if !hasWinProbability(r.Plays) {
+		return ""
+	}
+
+	series := service.HomeWinSeries(r.Plays)
+	swings := service.BiggestSwings(r.Plays, winSwingCount)
+	cols := min(len(series), max(width, 10))
+	column := func(i int) int { return i * cols / len(series) }
+
+	values := make([]float64, cols)
+	for i, v := range series {
+		values[column(i)] = v
+	}
+	marks := make([]rune, cols)
+	for i := range marks {
+		marks[i] = ' '
+	}
+	swingMark, cursorMark := '◆', '▲'
+	levels := sparkLevels
+	if plain {
+		swingMark, cursorMark = '*', '^'
+		levels = plainSparkLevels
+	}
+	for _, s := range swings {
+		marks[column(s.Index)] = swingMark
+	}
+	cursor := column(index)
+	marks[cursor] = cursorMark
+
+	var spark strings.Builder
+	for _, v := range values {
+		level := int(math.Round(v * float64(len(levels)-1)))
+		spark.WriteRune(levels[max(0, min(level, len(levels)-1))])
+	}
+	line := []rune(spark.String())
+
+	g := r.Game
+	home := fmt.Sprintf("%-4s", g.HomeTeam.Abbreviation)
+	title := "WIN PROBABILITY"
+	wp := r.Plays[index].WinProbability
+	if wp == nil {
+		wp = &models.WinProbability{Home: series[index]}
+	}
+	title += "  " + winProbabilityText(g, wp)
+
+	var sb strings.Builder
+	if plain {
+		sb.WriteString("  " + title + "\n")
+		sb.WriteString("  " + home + string(line) + "\n")
+		sb.WriteString("      " + string(marks) + "\n")
+	} else {
+		played := lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+		ahead := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+		label := lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+		sb.WriteString("  " + lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Render(title) + "\n")
+		sb.WriteString("  " + label.Render(home) + played.Render(string(line[:cursor+1])) + ahead.Render(string(line[cursor+1:])) + "\n")
+		markLine := strings.NewReplacer(
+			string(cursorMark), lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Render(string(cursorMark)),
+			string(swingMark), lipgloss.NewStyle().Foreground(lipgloss.Color("201")).Render(string(swingMark)),
+		).Replace(string(marks))
+		sb.WriteString("      " + markLine + "\n")
+	}
+
+	if len(swings) > 0 {
+		parts := make([]string, 0, len(swings))
+		for _, s := range swings {
+			p := r.Plays[s.Index]
+			team := g.HomeTeam.Abbreviation
+			if s.Change < 0 {
+				team = g.AwayTeam.Abbreviation
+			}
+			parts = append(parts, fmt.Sprintf("Q%d %s %s +%s", p.Period, p.Clock, team, percent(math.Abs(s.Change))))
+		}
+		swingText := fmt.Sprintf("  Biggest swings %c %s", swingMark, strings.Join(parts, " · "))
+		if !plain {
+			swingText = lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render(swingText)
+		}
+		sb.WriteString(swingText + "\n")
+	}
+	return sb.String()
+}
+
+// hasWinProbability reports whether any play carries a win probability
+func hasWinProbability(plays []models.ReplayPlay) bool {
+	for _, p := range plays {
+		if p.WinProbability != nil {
+			return true
+		}
+	}
+	return false
+}
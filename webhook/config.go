@@ -0,0 +1,133 @@
+// Package webhook POSTs signed notifications to user-configured URLs when
+// scoring plays, turnovers and final scores happen in live games
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"nfl-scores/config"
+)
+
+// Kind is a notification type an endpoint can subscribe to
+type Kind string
+
+// Notification kinds
+const (
+	KindTouchdown Kind = "touchdown"
+	KindFieldGoal Kind = "field_goal"
+	KindTurnover  Kind = "turnover"
+	KindFinal     Kind = "final"
+	KindPing      Kind = "ping" // Sent by --test only; ignores filters
+)
+
+// defaultCloseMargin is one score: a touchdown and two-point conversion
+const defaultCloseMargin = 8
+
+// Config is the webhooks file
+type Config struct {
+	Webhooks []Endpoint `json:"webhooks"`
+}
+
+// Endpoint is one webhook URL and the notifications it wants. Empty Teams
+// or Events match everything.
+type Endpoint struct {
+	URL           string   `json:"url"`
+	Secret        string   `json:"secret,omitempty"` // HMAC-SHA256 key for the signature header
+	Teams         []string `json:"teams,omitempty"`  // Team abbreviations, either side of the game
+	Events        []Kind   `json:"events,omitempty"`
+	CloseGameOnly bool     `json:"close_game_only,omitempty"`
+	CloseMargin   int      `json:"close_margin,omitempty"` // Largest margin that counts as close (default 8)
+}
+
+// DefaultConfigPath is webhooks.json next to the main config file
+func DefaultConfigPath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "webhooks.json"), nil
+}
+
+// LoadConfig reads and validates a webhooks file
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read webhooks config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse webhooks config %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return cfg, fmt.Errorf("invalid webhooks config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	if len(c.Webhooks) == 0 {
+		return errors.New("no webhooks configured")
+	}
+	for i, ep := range c.Webhooks {
+		u, err := url.Parse(ep.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook %d: url must be an http(s) URL, got %q", i+1, ep.URL)
+		}
+		for _, k := range ep.Events {
+			switch k {
+			case KindTouchdown, KindFieldGoal, KindTurnover, KindFinal:
+			default:
+				return fmt.Errorf("webhook %d: unknown event %q (want touchdown, field_goal, turnover or final)", i+1, k)
+			}
+		}
+		if ep.CloseMargin < 0 {
+			return fmt.Errorf("webhook %d: close_margin must not be negative", i+1)
+		}
+	}
+	return nil
+}
+
+// wants reports whether a notification of kind about a game between home
+// and away, with the given score margin, passes the endpoint's filters
+func (ep Endpoint) wants(kind Kind, home, away string, margin int) bool {
+	if len(ep.Events) > 0 && !containsKind(ep.Events, kind) {
+		return false
+	}
+	if len(ep.Teams) > 0 && !containsTeam(ep.Teams, home) && !containsTeam(ep.Teams, away) {
+		return false
+	}
+	if ep.CloseGameOnly {
+		limit := ep.CloseMargin
+		if limit == 0 {
+			limit = defaultCloseMargin
+		}
+		if margin > limit {
+			return false
+		}
+	}
+	return true
+}
+
+func containsKind(kinds []Kind, k Kind) bool {
+	for _, want := range kinds {
+		if want == k {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTeam(teams []string, abbr string) bool {
+	for _, t := range teams {
+		if strings.EqualFold(t, abbr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/models"
+	"nfl-scores/output"
+)
+
+// Request headers sent with every delivery
+const (
+	HeaderEvent     = "X-NFL-Event"
+	HeaderDelivery  = "X-NFL-Delivery"
+	HeaderSignature = "X-NFL-Signature-256" // "sha256=" + hex HMAC of the body
+)
+
+// Delivery defaults
+const (
+	defaultAttempts = 4
+	defaultBackoff  = time.Second
+	deliveryTimeout = 10 * time.Second
+	queueSize       = 64          // Pending notifications per endpoint before new ones are dropped
+	maxRetryAfter   = time.Minute // Longer Retry-After waits are cut short so one receiver can't stall its queue
+)
+
+// delivery is one queued POST
+type delivery struct {
+	id   string
+	kind Kind
+	body []byte
+}
+
+// endpointQueue delivers to one endpoint in order, so a slow or failing
+// receiver doesn't hold up the others
+type endpointQueue struct {
+	Endpoint
+	pending chan delivery
+}
+
+// Dispatcher filters notifications and delivers them with retries
+type Dispatcher struct {
+	queues   []*endpointQueue
+	client   *http.Client
+	logger   *log.Logger
+	attempts int
+	backoff  time.Duration
+	now      func() time.Time
+	wg       sync.WaitGroup
+}
+
+// Option configures a Dispatcher
+type Option func(*Dispatcher)
+
+// WithHTTPClient sets the client used for deliveries
+func WithHTTPClient(c *http.Client) Option {
+	return func(d *Dispatcher) {
+		d.client = c
+	}
+}
+
+// WithLogger sets where delivery results are logged (default stderr)
+func WithLogger(l *log.Logger) Option {
+	return func(d *Dispatcher) {
+		d.logger = l
+	}
+}
+
+// WithRetry sets how many times a delivery is attempted and the first
+// backoff, which doubles after each failure
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return func(d *Dispatcher) {
+		d.attempts = max(attempts, 1)
+		d.backoff = backoff
+	}
+}
+
+// NewDispatcher starts one delivery worker per configured endpoint
+func NewDispatcher(cfg Config, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		client:   &http.Client{Timeout: deliveryTimeout},
+		logger:   log.New(os.Stderr, "webhook: ", log.LstdFlags),
+		attempts: defaultAttempts,
+		backoff:  defaultBackoff,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	for _, ep := range cfg.Webhooks {
+		q := &endpointQueue{Endpoint: ep, pending: make(chan delivery, queueSize)}
+		d.queues = append(d.queues, q)
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for job := range q.pending {
+				d.deliver(context.Background(), q.Endpoint, job)
+			}
+		}()
+	}
+	return d
+}
+
+// Notify queues a notification for every endpoint whose filters match.
+// play is nil for final scores.
+func (d *Dispatcher) Notify(kind Kind, game models.Game, play *models.Play) {
+	home, away := game.HomeTeam.Score, game.AwayTeam.Score
+	if play != nil {
+		home, away = play.HomeScore, play.AwayScore
+	}
+	margin := max(home-away, away-home)
+
+	for _, q := range d.queues {
+		if !q.wants(kind, game.HomeTeam.Abbreviation, game.AwayTeam.Abbreviation, margin) {
+			continue
+		}
+		job, err := d.newDelivery(kind, &game, play)
+		if err != nil {
+			d.logger.Printf("%s for game %s: %v", kind, game.ID, err)
+			continue
+		}
+		select {
+		case q.pending <- job:
+		default:
+			d.logger.Printf("%s %s -> %s dropped: delivery queue full", kind, job.id, q.URL)
+		}
+	}
+}
+
+// Ping sends a ping notification to every endpoint, ignoring filters, and
+// reports the first endpoint that could not be reached
+func (d *Dispatcher) Ping(ctx context.Context) error {
+	var firstErr error
+	for _, q := range d.queues {
+		job, err := d.newDelivery(KindPing, nil, nil)
+		if err == nil {
+			err = d.deliver(ctx, q.Endpoint, job)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("%s: %w", q.URL, err)
+		}
+	}
+	return firstErr
+}
+
+// Close stops accepting notifications and waits for queued ones to be
+// delivered or given up on
+func (d *Dispatcher) Close() {
+	for _, q := range d.queues {
+		close(q.pending)
+	}
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) newDelivery(kind Kind, game *models.Game, play *models.Play) (delivery, error) {
+	id, err := newDeliveryID()
+	if err != nil {
+		return delivery{}, err
+	}
+	body, err := json.Marshal(output.NewNotification(string(kind), id, d.now(), game, play))
+	if err != nil {
+		return delivery{}, fmt.Errorf("failed to encode notification: %w", err)
+	}
+	return delivery{id: id, kind: kind, body: body}, nil
+}
+
+// deliver POSTs job until it succeeds, fails permanently or runs out of
+// attempts. Network errors, 429 and 5xx are retried; other statuses are not.
+// A receiver's Retry-After replaces the backoff for the next attempt.
+func (d *Dispatcher) deliver(ctx context.Context, ep Endpoint, job delivery) error {
+	backoff := d.backoff
+	for attempt := 1; ; attempt++ {
+		start := d.now()
+		status, retryAfter, err := d.post(ctx, ep, job)
+		elapsed := d.now().Sub(start).Round(time.Millisecond)
+		if err == nil {
+			d.logger.Printf("%s %s -> %s delivered (%d, attempt %d, %s)", job.kind, job.id, ep.URL, status, attempt, elapsed)
+			return nil
+		}
+
+		retryable := status == 0 || status == http.StatusTooManyRequests || status >= 500
+		if !retryable || attempt >= d.attempts {
+			d.logger.Printf("%s %s -> %s failed after %d attempt(s): %v", job.kind, job.id, ep.URL, attempt, err)
+			return err
+		}
+		wait := backoff
+		if retryAfter > 0 {
+			wait = min(retryAfter, maxRetryAfter)
+		}
+		d.logger.Printf("%s %s -> %s attempt %d failed: %v; retrying in %s", job.kind, job.id, ep.URL, attempt, err, wait)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// post makes one delivery attempt and returns the response status (0 when
+// no response arrived) and any Retry-After the receiver asked for
+func (d *Dispatcher) post(ctx context.Context, ep Endpoint, job delivery) (int, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(job.body))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "nfl-scores-webhook/1")
+	req.Header.Set(HeaderEvent, string(job.kind))
+	req.Header.Set(HeaderDelivery, job.id)
+	if ep.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(ep.Secret, job.body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, client.ParseRetryAfter(resp.Header.Get("Retry-After")), fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.StatusCode, 0, nil
+}
+
+// Sign returns the signature header value for body: "sha256=" followed by
+// the hex HMAC-SHA256 of the raw body keyed with secret. Receivers should
+// compute the same value and compare with hmac.Equal.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newDeliveryID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate delivery ID: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"nfl-scores/models"
+)
+
+// quiet keeps delivery logs out of test output
+var quiet = WithLogger(log.New(io.Discard, "", 0))
+
+// received is one request as the receiver saw it
+type received struct {
+	at     time.Time
+	header http.Header
+	body   []byte
+}
+
+// receiver is a webhook endpoint that answers with scripted statuses,
+// repeating the last one once the script runs out
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []received
+}
+
+// response is one scripted answer
+type response struct {
+	status     int
+	retryAfter string
+}
+
+func newReceiver(t *testing.T, script ...response) *receiver {
+	t.Helper()
+	if len(script) == 0 {
+		script = []response{{status: http.StatusNoContent}}
+	}
+	rc := &receiver{}
+	rc.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rc.mu.Lock()
+		n := len(rc.requests)
+		rc.requests = append(rc.requests, received{at: time.Now(), header: r.Header.Clone(), body: body})
+		rc.mu.Unlock()
+
+		resp := script[min(n, len(script)-1)]
+		if resp.retryAfter != "" {
+			w.Header().Set("Retry-After", resp.retryAfter)
+		}
+		w.WriteHeader(resp.status)
+	}))
+	t.Cleanup(rc.Close)
+	return rc
+}
+
+func (rc *receiver) got() []received {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]received(nil), rc.requests...)
+}
+
+// game is a KC @ BUF game with the given score
+func game(away, home int) models.Game {
+	return models.Game{
+		ID:       "401671001",
+		AwayTeam: models.Team{Abbreviation: "KC", Score: away},
+		HomeTeam: models.Team{Abbreviation: "BUF", Score: home},
+		Status:   models.StatusInProgress,
+	}
+}
+
+func TestDeliverySignature(t *testing.T) {
+	rc := newReceiver(t)
+	d := NewDispatcher(Config{Webhooks: []Endpoint{{URL: rc.URL, Secret: "s3cret"}}}, quiet)
+	d.Notify(KindTouchdown, game(7, 0), &models.Play{ID: "1", Type: "Passing Touchdown", AwayScore: 7, ScoringPlay: true})
+	d.Close()
+
+	reqs := rc.got()
+	if len(reqs) != 1 {
+		t.Fatalf("received %d requests, want 1", len(reqs))
+	}
+	r := reqs[0]
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(r.body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := r.header.Get(HeaderSignature); got != want {
+		t.Errorf("%s = %q, want %q", HeaderSignature, got, want)
+	}
+	if got := r.header.Get(HeaderEvent); got != string(KindTouchdown) {
+		t.Errorf("%s = %q, want touchdown", HeaderEvent, got)
+	}
+
+	var doc struct {
+		Event      string `json:"event"`
+		DeliveryID string `json:"delivery_id"`
+	}
+	if err := json.Unmarshal(r.body, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Event != "touchdown" || doc.DeliveryID == "" || doc.DeliveryID != r.header.Get(HeaderDelivery) {
+		t.Errorf("body event=%q delivery=%q, header delivery=%q", doc.Event, doc.DeliveryID, r.header.Get(HeaderDelivery))
+	}
+}
+
+func TestDeliveryUnsigned(t *testing.T) {
+	rc := newReceiver(t)
+	d := NewDispatcher(Config{Webhooks: []Endpoint{{URL: rc.URL}}}, quiet)
+	defer d.Close()
+	if err := d.Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if sig := rc.got()[0].header.Get(HeaderSignature); sig != "" {
+		t.Errorf("endpoint without a secret got signature %q", sig)
+	}
+}
+
+func TestDeliveryRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   []response
+		attempts int
+		wantErr  bool
+		minGap   time.Duration // Between the last two attempts
+	}{
+		{"success", []response{{status: 200}}, 1, false, 0},
+		{"5xx then success", []response{{status: 500}, {status: 204}}, 2, false, 0},
+		{"429 then success", []response{{status: 429}, {status: 200}}, 2, false, 0},
+		{"429 honors Retry-After", []response{{status: 429, retryAfter: "1"}, {status: 200}}, 2, false, time.Second},
+		{"503 honors Retry-After", []response{{status: 503, retryAfter: "1"}, {status: 200}}, 2, false, time.Second},
+		{"5xx until attempts run out", []response{{status: 502}}, 3, true, 0},
+		{"400 is not retried", []response{{status: 400}}, 1, true, 0},
+		{"404 is not retried", []response{{status: 404}}, 1, true, 0},
+		{"410 is not retried", []response{{status: 410}}, 1, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := newReceiver(t, tt.script...)
+			d := NewDispatcher(Config{Webhooks: []Endpoint{{URL: rc.URL}}}, quiet, WithRetry(3, time.Millisecond))
+			defer d.Close()
+
+			err := d.Ping(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want error %v", err, tt.wantErr)
+			}
+			reqs := rc.got()
+			if len(reqs) != tt.attempts {
+				t.Fatalf("attempts = %d, want %d", len(reqs), tt.attempts)
+			}
+			if tt.minGap > 0 {
+				if gap := reqs[len(reqs)-1].at.Sub(reqs[len(reqs)-2].at); gap < tt.minGap {
+					t.Errorf("retried after %s, want at least %s", gap, tt.minGap)
+				}
+			}
+			// Retries resend the same delivery
+			if first, last := reqs[0].header.Get(HeaderDelivery), reqs[len(reqs)-1].header.Get(HeaderDelivery); first != last {
+				t.Errorf("delivery ID changed between attempts: %s, %s", first, last)
+			}
+		})
+	}
+}
+
+func TestNotifyFilters(t *testing.T) {
+	all := newReceiver(t)
+	touchdowns := newReceiver(t)
+	chiefs := newReceiver(t)
+	eagles := newReceiver(t)
+	tight := newReceiver(t)
+	d := NewDispatcher(Config{Webhooks: []Endpoint{
+		{URL: all.URL},
+		{URL: touchdowns.URL, Events: []Kind{KindTouchdown}},
+		{URL: chiefs.URL, Teams: []string{"kc"}},
+		{URL: eagles.URL, Teams: []string{"PHI"}},
+		{URL: tight.URL, CloseGameOnly: true, CloseMargin: 3},
+	}}, quiet)
+
+	d.Notify(KindTouchdown, game(7, 0), &models.Play{AwayScore: 7, ScoringPlay: true})         // Margin 7
+	d.Notify(KindFieldGoal, game(7, 3), &models.Play{AwayScore: 7, HomeScore: 3})              // Margin 4
+	d.Notify(KindTurnover, game(7, 3), &models.Play{AwayScore: 7, HomeScore: 3})               // Margin 4
+	d.Notify(KindTouchdown, game(7, 10), &models.Play{AwayScore: 7, HomeScore: 10})            // Margin 3
+	d.Notify(KindFinal, models.Game{ID: "2", AwayTeam: models.Team{Abbreviation: "NYG"}}, nil) // Other teams, margin 0
+	d.Close()
+
+	for name, tt := range map[string]struct {
+		rc   *receiver
+		want []Kind
+	}{
+		"unfiltered":      {all, []Kind{KindTouchdown, KindFieldGoal, KindTurnover, KindTouchdown, KindFinal}},
+		"touchdowns":      {touchdowns, []Kind{KindTouchdown, KindTouchdown}},
+		"chiefs":          {chiefs, []Kind{KindTouchdown, KindFieldGoal, KindTurnover, KindTouchdown}},
+		"eagles":          {eagles, nil},
+		"within 3 points": {tight, []Kind{KindTouchdown, KindFinal}},
+	} {
+		var got []Kind
+		for _, r := range tt.rc.got() {
+			got = append(got, Kind(r.header.Get(HeaderEvent)))
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s endpoint got %v, want %v", name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s endpoint got %v, want %v", name, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"nfl-scores/models"
+	"nfl-scores/service"
+)
+
+// Watcher polls live games and turns their events into notifications
+type Watcher struct {
+	service    *service.ScoreService
+	poll       service.PollConfig
+	dispatcher *Dispatcher
+	logf       func(format string, args ...any)
+}
+
+// NewWatcher creates a watcher that polls through svc and notifies d
+func NewWatcher(svc *service.ScoreService, poll service.PollConfig, d *Dispatcher) *Watcher {
+	return &Watcher{service: svc, poll: poll, dispatcher: d, logf: d.logger.Printf}
+}
+
+// Run watches gameID until it is final, or every live game (checking for
+// newly started ones each poll.MaxInterval) until ctx is cancelled
+func (w *Watcher) Run(ctx context.Context, gameID string) error {
+	if gameID != "" {
+		return w.watchGame(ctx, gameID)
+	}
+
+	discover := w.poll.MaxInterval
+	if discover <= 0 {
+		discover = service.DefaultMaxPollInterval
+	}
+
+	var (
+		mu       sync.Mutex
+		watching = make(map[string]bool)
+		finished = make(map[string]bool) // Already sent their final; the scoreboard can lag
+		wg       sync.WaitGroup
+	)
+	defer wg.Wait()
+
+	for {
+		games, err := w.service.GetLiveGames(service.Polling(ctx))
+		if err != nil && ctx.Err() == nil {
+			w.logf("failed to list live games: %v", err)
+		}
+		for _, g := range games {
+			mu.Lock()
+			skip := watching[g.ID] || finished[g.ID]
+			if !skip {
+				watching[g.ID] = true
+			}
+			mu.Unlock()
+			if skip {
+				continue
+			}
+
+			w.logf("watching %s @ %s (%s)", g.AwayTeam.Abbreviation, g.HomeTeam.Abbreviation, g.ID)
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				err := w.watchGame(ctx, id)
+				if err != nil {
+					w.logf("stopped watching %s: %v", id, err)
+				}
+				mu.Lock()
+				delete(watching, id)
+				// A watch only ends cleanly at the final whistle; failed ones are retried
+				if err == nil && ctx.Err() == nil {
+					finished[id] = true
+				}
+				mu.Unlock()
+			}(g.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(discover):
+		}
+	}
+}
+
+// watchGame polls one game and notifies for each matching event
+func (w *Watcher) watchGame(ctx context.Context, gameID string) error {
+	var home, away int // Score before the next scoring event
+	return w.service.WatchGame(ctx, gameID, w.poll, func(s *models.GameSummary, events []service.Event) error {
+		for _, e := range events {
+			if kind, ok := classify(e, home+away); ok {
+				w.dispatcher.Notify(kind, s.Game, e.Play)
+			}
+			if e.Play != nil && e.Play.ScoringPlay {
+				home, away = e.Play.HomeScore, e.Play.AwayScore
+			}
+		}
+		home, away = s.Game.HomeTeam.Score, s.Game.AwayTeam.Score
+		return nil
+	})
+}
+
+// classify maps a game event to a notification kind. prevTotal is the
+// combined score before the event, used to size scores whose play text
+// doesn't say what they were.
+func classify(e service.Event, prevTotal int) (Kind, bool) {
+	switch e.Type {
+	case service.EventTurnover:
+		return KindTurnover, true
+	case service.EventGameFinal:
+		return KindFinal, true
+	case service.EventScoringPlay:
+	default:
+		return "", false
+	}
+
+	total := e.HomeScore + e.AwayScore
+	if e.Play != nil {
+		t := strings.ToLower(e.Play.Type)
+		switch {
+		case strings.Contains(t, "touchdown"):
+			return KindTouchdown, true
+		case strings.Contains(t, "field goal"):
+			return KindFieldGoal, true
+		}
+		total = e.Play.HomeScore + e.Play.AwayScore
+	}
+
+	// Touchdowns are 6 plus any conversion already counted; safeties and
+	// lone conversions aren't notified
+	switch points := total - prevTotal; {
+	case points >= 6:
+		return KindTouchdown, true
+	case points == 3:
+		return KindFieldGoal, true
+	}
+	return "", false
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"nfl-scores/client"
+	"nfl-scores/fakeespn"
+	"nfl-scores/service"
+)
+
+// fastPolls keeps the watcher from sleeping between polls of the fake server
+var fastPolls = service.PollConfig{Interval: time.Millisecond, MinInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+// laggingScoreboard serves the scripted game, except that the scoreboard
+// keeps showing it in progress after it ends, as ESPN's sometimes does
+func laggingScoreboard(t *testing.T) *service.ScoreService {
+	t.Helper()
+	fake, err := fakeespn.New(fakeespn.WithLiveGame(fakeespn.DefaultLiveGameID, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	fake.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, fakeespn.ScoreboardPath, nil))
+	stale := rec.Body.Bytes()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == fakeespn.ScoreboardPath {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(stale)
+			return
+		}
+		fake.Handler().ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return service.NewScoreService(client.NewESPNClientWithBaseURL(srv.URL))
+}
+
+func TestRunSendsOneFinalPerGame(t *testing.T) {
+	rc := newReceiver(t)
+	d := NewDispatcher(Config{Webhooks: []Endpoint{{URL: rc.URL, Events: []Kind{KindFinal}}}}, quiet)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- NewWatcher(laggingScoreboard(t), fastPolls, d).Run(ctx, "") }()
+
+	// Wait for the game to end, then give discovery time to re-watch it
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rc.got()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("no final notification")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	d.Close()
+
+	if n := len(rc.got()); n != 1 {
+		t.Errorf("sent %d final notifications, want 1", n)
+	}
+}
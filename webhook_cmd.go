@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"nfl-scores/service"
+	"nfl-scores/webhook"
+)
+
+// runWebhooks watches live games and POSTs matching events to the
+// configured webhooks
+func runWebhooks(args []string) {
+	// Exit only after sendWebhooks returns, so its deferred Close has
+	// delivered or given up on every queued notification
+	if err := sendWebhooks(args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func sendWebhooks(args []string) error {
+	fs := flag.NewFlagSet("webhooks", flag.ExitOnError)
+	configPath := fs.String("config", "", "Webhooks file (default: webhooks.json in the user config directory)")
+	gameID := fs.String("game", "", "Watch one game instead of every live game")
+	espn := addClientFlags(fs)
+	logPath := fs.String("log", "", "Append the delivery log to FILE instead of stderr")
+	test := fs.Bool("test", false, "Send a ping to every webhook and exit")
+	fs.Parse(args)
+
+	if *configPath == "" {
+		path, err := webhook.DefaultConfigPath()
+		if err != nil {
+			return err
+		}
+		*configPath = path
+	}
+	cfg, err := webhook.LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	logger := log.New(os.Stderr, "webhook: ", log.LstdFlags)
+	if *logPath != "" {
+		f, err := os.OpenFile(*logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open delivery log: %w", err)
+		}
+		defer f.Close()
+		logger = log.New(f, "webhook: ", log.LstdFlags)
+	}
+	dispatcher := webhook.NewDispatcher(cfg, webhook.WithLogger(logger))
+	defer dispatcher.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if *test {
+		return dispatcher.Ping(ctx)
+	}
+
+	svc := service.NewScoreService(newESPNClient(espn))
+	watcher := webhook.NewWatcher(svc, service.DefaultPollConfig(), dispatcher)
+	return watcher.Run(ctx, *gameID)
+}